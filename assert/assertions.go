@@ -2,6 +2,9 @@
 // It contains the core assertion logic, which is then exposed through the top-level
 // `should` package. This package handles value comparisons, error formatting,
 // and detailed difference reporting.
+//
+// Every assertion reports whether it passed, so that wrappers such as the `must`
+// package can decide how to react to a failure.
 package assert
 
 import (
@@ -56,13 +59,15 @@ func failWithOptions(t testing.TB, cfg *Config, format string, args ...any) {
 //	should.BeTrue(t, true)
 //
 //	should.BeTrue(t, user.IsActive, should.WithMessage("User must be active"))
-func BeTrue(t testing.TB, actual bool, opts ...Option) {
+func BeTrue(t testing.TB, actual bool, opts ...Option) bool {
 	t.Helper()
 
 	if !actual {
 		cfg := processOptions(opts...)
 		failWithOptions(t, cfg, "Expected true, got false")
+		return false
 	}
+	return true
 }
 
 // BeFalse reports a test failure if the value is not false.
@@ -75,13 +80,15 @@ func BeTrue(t testing.TB, actual bool, opts ...Option) {
 //	should.BeFalse(t, false)
 //
 //	should.BeFalse(t, user.IsDeleted, should.WithMessage("User should not be deleted"))
-func BeFalse(t testing.TB, actual bool, opts ...Option) {
+func BeFalse(t testing.TB, actual bool, opts ...Option) bool {
 	t.Helper()
 
 	if actual {
 		cfg := processOptions(opts...)
 		failWithOptions(t, cfg, "Expected false, got true")
+		return false
 	}
+	return true
 }
 
 // BeEmpty reports a test failure if the value is not empty.
@@ -100,13 +107,13 @@ func BeFalse(t testing.TB, actual bool, opts ...Option) {
 //	should.BeEmpty(t, map[string]int{})
 //
 // Only works with strings, slices, arrays, maps, channels, or pointers.
-func BeEmpty(t testing.TB, actual any, opts ...Option) {
+func BeEmpty(t testing.TB, actual any, opts ...Option) bool {
 	t.Helper()
 	actualValue := reflect.ValueOf(actual)
 
 	// Handle nil values
 	if !actualValue.IsValid() {
-		return // nil is considered empty
+		return true // nil is considered empty
 	}
 
 	// Check if the type supports Len()
@@ -116,16 +123,20 @@ func BeEmpty(t testing.TB, actual any, opts ...Option) {
 			cfg := processOptions(opts...)
 			errorMsg := formatEmptyError(actual, true)
 			failWithOptions(t, cfg, errorMsg)
+			return false
 		}
+		return true
 	case reflect.Ptr:
 		if actualValue.IsNil() {
-			return // nil pointer is considered empty
+			return true // nil pointer is considered empty
 		}
 		cfg := processOptions(opts...)
 		errorMsg := formatEmptyError(actual, true)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	default:
 		fail(t, "BeEmpty can only be used with strings, slices, arrays, maps, channels, or pointers, but got %T", actual)
+		return false
 	}
 }
 
@@ -144,7 +155,7 @@ func BeEmpty(t testing.TB, actual any, opts ...Option) {
 //	should.NotBeEmpty(t, &user)
 //
 // Only works with strings, slices, arrays, maps, channels, or pointers.
-func NotBeEmpty(t testing.TB, actual any, opts ...Option) {
+func NotBeEmpty(t testing.TB, actual any, opts ...Option) bool {
 	t.Helper()
 	actualValue := reflect.ValueOf(actual)
 
@@ -153,7 +164,7 @@ func NotBeEmpty(t testing.TB, actual any, opts ...Option) {
 		cfg := processOptions(opts...)
 		errorMsg := formatEmptyError(actual, false)
 		failWithOptions(t, cfg, errorMsg)
-		return
+		return false
 	}
 
 	// Check if the type supports Len()
@@ -163,16 +174,20 @@ func NotBeEmpty(t testing.TB, actual any, opts ...Option) {
 			cfg := processOptions(opts...)
 			errorMsg := formatEmptyError(actual, false)
 			failWithOptions(t, cfg, errorMsg)
+			return false
 		}
 	case reflect.Ptr:
 		if actualValue.IsNil() {
 			cfg := processOptions(opts...)
 			errorMsg := formatEmptyError(actual, false)
 			failWithOptions(t, cfg, errorMsg)
+			return false
 		}
 	default:
 		fail(t, "NotBeEmpty can only be used with strings, slices, arrays, maps, channels, or pointers, but got %T", actual)
+		return false
 	}
+	return true
 }
 
 // BeNil reports a test failure if the value is not nil.
@@ -189,12 +204,12 @@ func NotBeEmpty(t testing.TB, actual any, opts ...Option) {
 //	should.BeNil(t, slice, should.WithMessage("Slice should be nil"))
 //
 // Only works with nillable types (pointers, interfaces, channels, functions, slices, maps).
-func BeNil(t testing.TB, actual any, opts ...Option) {
+func BeNil(t testing.TB, actual any, opts ...Option) bool {
 	t.Helper()
 	v := reflect.ValueOf(actual)
 
 	if !v.IsValid() {
-		return // A nil interface is considered nil.
+		return true // A nil interface is considered nil.
 	}
 
 	kind := v.Kind()
@@ -207,13 +222,15 @@ func BeNil(t testing.TB, actual any, opts ...Option) {
 
 	if !nillable {
 		fail(t, "BeNil can only be used with nillable types, but got %T", actual)
-		return
+		return false
 	}
 
 	if !v.IsNil() {
 		cfg := processOptions(opts...)
 		failWithOptions(t, cfg, "Expected nil, but was not")
+		return false
 	}
+	return true
 }
 
 // NotBeNil reports a test failure if the value is nil.
@@ -229,7 +246,7 @@ func BeNil(t testing.TB, actual any, opts ...Option) {
 //	should.NotBeNil(t, make([]int, 0))
 //
 // Only works with nillable types (pointers, interfaces, channels, functions, slices, maps).
-func NotBeNil(t testing.TB, actual any, opts ...Option) {
+func NotBeNil(t testing.TB, actual any, opts ...Option) bool {
 	t.Helper()
 	v := reflect.ValueOf(actual)
 
@@ -245,7 +262,7 @@ func NotBeNil(t testing.TB, actual any, opts ...Option) {
 
 		if !nillable {
 			fail(t, "NotBeNil can only be used with nillable types, but got %T", actual)
-			return
+			return false
 		}
 		isNil = v.IsNil()
 	}
@@ -253,7 +270,9 @@ func NotBeNil(t testing.TB, actual any, opts ...Option) {
 	if isNil {
 		cfg := processOptions(opts...)
 		failWithOptions(t, cfg, "Expected not nil, but was nil")
+		return false
 	}
+	return true
 }
 
 // BeError reports a test failure if the provided error is nil.
@@ -267,14 +286,16 @@ func NotBeNil(t testing.TB, actual any, opts ...Option) {
 //
 //	should.BeError(t, err)
 //	should.BeError(t, err, should.WithMessage("Expected a validation error"))
-func BeError(t testing.TB, err error, opts ...Option) {
+func BeError(t testing.TB, err error, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
 
 	if err == nil {
 		failWithOptions(t, cfg, "Expected an error, but got nil")
+		return false
 	}
+	return true
 }
 
 // NotBeError - no error required
@@ -288,7 +309,7 @@ func BeError(t testing.TB, err error, opts ...Option) {
 //
 //	_, err = os.Open("/nonexistent/file.txt")
 //	should.NotBeError(t, err, should.WithMessage("File should exist and be readable"))
-func NotBeError(t testing.TB, err error, opts ...Option) {
+func NotBeError(t testing.TB, err error, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
@@ -296,7 +317,9 @@ func NotBeError(t testing.TB, err error, opts ...Option) {
 	if err != nil {
 		errorMsg := formatNotBeErrorMessage(err)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeErrorAs reports a test failure if the provided error does not match
@@ -311,25 +334,27 @@ func NotBeError(t testing.TB, err error, opts ...Option) {
 //	var pathErr *os.PathError
 //	should.BeErrorAs(t, err, &pathErr)
 //	should.BeErrorAs(t, err, &MyCustomError{}, should.WithMessage("Expected custom error type"))
-func BeErrorAs(t testing.TB, err error, target interface{}, opts ...Option) {
+func BeErrorAs(t testing.TB, err error, target interface{}, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
 
 	if err == nil {
 		failWithOptions(t, cfg, "Expected error to be %T, but got nil", target)
-		return
+		return false
 	}
 
 	if target == nil {
 		fail(t, "target cannot be nil")
-		return
+		return false
 	}
 
 	if !errors.As(err, target) {
 		errorMsg := formatBeErrorMessage("as", err, target)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeErrorIs reports a test failure if the provided error is not equal to
@@ -343,20 +368,22 @@ func BeErrorAs(t testing.TB, err error, target interface{}, opts ...Option) {
 //
 //	should.BeErrorIs(t, err, io.EOF)
 //	should.BeErrorIs(t, err, ErrUnauthorized, should.WithMessage("Expected unauthorized error"))
-func BeErrorIs(t testing.TB, err error, target error, opts ...Option) {
+func BeErrorIs(t testing.TB, err error, target error, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
 
 	if err == nil {
 		failWithOptions(t, cfg, "Expected error to be \"%s\", but got nil", target)
-		return
+		return false
 	}
 
 	if !errors.Is(err, target) {
 		errorMsg := formatBeErrorMessage("is", err, target)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeGreaterThan reports a test failure if the value is not greater than the expected threshold.
@@ -374,20 +401,22 @@ func BeErrorIs(t testing.TB, err error, target error, opts ...Option) {
 //	should.BeGreaterThan(t, 3.14, 2.71)
 //
 // Only works with numeric types. Both values must be of the same type.
-func BeGreaterThan[T Ordered](t testing.TB, actual T, expected T, opts ...Option) {
+func BeGreaterThan[T Ordered](t testing.TB, actual T, expected T, opts ...Option) bool {
 	t.Helper()
 
 	result, err := compareOrdered(actual, expected)
 	if err != nil {
 		fail(t, "cannot compare values: %v", err)
-		return
+		return false
 	}
 
 	if result <= 0 {
 		cfg := processOptions(opts...)
 		errorMsg := formatNumericComparisonError(actual, expected, "greater")
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeLessThan reports a test failure if the value is not less than the expected threshold.
@@ -405,20 +434,22 @@ func BeGreaterThan[T Ordered](t testing.TB, actual T, expected T, opts ...Option
 //	should.BeLessThan(t, 2.71, 3.14)
 //
 // Only works with numeric types. Both values must be of the same type.
-func BeLessThan[T Ordered](t testing.TB, actual T, expected T, opts ...Option) {
+func BeLessThan[T Ordered](t testing.TB, actual T, expected T, opts ...Option) bool {
 	t.Helper()
 
 	result, err := compareOrdered(actual, expected)
 	if err != nil {
 		fail(t, "cannot compare values: %v", err)
-		return
+		return false
 	}
 
 	if result >= 0 {
 		cfg := processOptions(opts...)
 		errorMsg := formatNumericComparisonError(actual, expected, "less")
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeGreaterOrEqualTo reports a test failure if the value is not greater than or equal to the expected threshold.
@@ -435,20 +466,22 @@ func BeLessThan[T Ordered](t testing.TB, actual T, expected T, opts ...Option) {
 //	should.BeGreaterOrEqualTo(t, 3.14, 3.14)
 //
 // Only works with numeric types. Both values must be of the same type.
-func BeGreaterOrEqualTo[T Ordered](t testing.TB, actual T, expected T, opts ...Option) {
+func BeGreaterOrEqualTo[T Ordered](t testing.TB, actual T, expected T, opts ...Option) bool {
 	t.Helper()
 
 	result, err := compareOrdered(actual, expected)
 	if err != nil {
 		fail(t, "cannot compare values: %v", err)
-		return
+		return false
 	}
 
 	if result < 0 {
 		cfg := processOptions(opts...)
 		errorMsg := formatNumericComparisonError(actual, expected, "greaterOrEqual")
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeLessOrEqualTo reports a test failure if the value is not less than or equal to the expected threshold.
@@ -465,20 +498,22 @@ func BeGreaterOrEqualTo[T Ordered](t testing.TB, actual T, expected T, opts ...O
 //	should.BeLessOrEqualTo(t, 3.14, 3.14)
 //
 // Only works with numeric types. Both values must be of the same type.
-func BeLessOrEqualTo[T Ordered](t testing.TB, actual T, expected T, opts ...Option) {
+func BeLessOrEqualTo[T Ordered](t testing.TB, actual T, expected T, opts ...Option) bool {
 	t.Helper()
 
 	result, err := compareOrdered(actual, expected)
 	if err != nil {
 		fail(t, "cannot compare values: %v", err)
-		return
+		return false
 	}
 
 	if result > 0 {
 		cfg := processOptions(opts...)
 		errorMsg := formatNumericComparisonError(actual, expected, "lessOrEqual")
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeWithin reports a test failure if the actual value is not within the given tolerance of the expected value.
@@ -494,12 +529,12 @@ func BeLessOrEqualTo[T Ordered](t testing.TB, actual T, expected T, opts ...Opti
 //	should.BeWithin(t, 3.14159, 3.14, 0.002)
 //
 //	should.BeWithin(t, 3.142, 3.14, 0.001, should.WithMessage("Pi approximation is outside the allowed range"))
-func BeWithin[T Float](t testing.TB, actual T, expected T, tolerance T, opts ...Option) {
+func BeWithin[T Float](t testing.TB, actual T, expected T, tolerance T, opts ...Option) bool {
 	t.Helper()
 
 	if tolerance < 0 {
 		fail(t, "Tolerance must be non-negative, got %v", tolerance)
-		return
+		return false
 	}
 
 	actualF := float64(actual)
@@ -508,15 +543,15 @@ func BeWithin[T Float](t testing.TB, actual T, expected T, tolerance T, opts ...
 
 	if math.IsNaN(actualF) || math.IsNaN(expectedF) || math.IsNaN(tolF) {
 		fail(t, "Invalid input: actual=%v, expected=%v, tolerance=%v (NaN detected)", actual, expected, tolerance)
-		return
+		return false
 	}
 
 	if math.IsInf(actualF, 0) || math.IsInf(expectedF, 0) {
 		if math.IsInf(actualF, 0) && math.IsInf(expectedF, 0) && math.Signbit(actualF) == math.Signbit(expectedF) {
-			return
+			return true
 		}
 		fail(t, "Invalid input: actual=%v, expected=%v (Inf mismatch)", actual, expected)
-		return
+		return false
 	}
 
 	diff := math.Abs(actualF - expectedF)
@@ -525,7 +560,9 @@ func BeWithin[T Float](t testing.TB, actual T, expected T, tolerance T, opts ...
 		errorMsg := formatBeWithinError(actual, expected, tolerance)
 		cfg := processOptions(opts...)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeInRange reports a test failure if the value is not within the specified range (inclusive).
@@ -543,16 +580,17 @@ func BeWithin[T Float](t testing.TB, actual T, expected T, tolerance T, opts ...
 //	should.BeInRange(t, 200, 200, 299, should.WithMessage("HTTP status should be 2xx"))
 //
 // Only works with numeric types. All values must be of the same type.
-func BeInRange[T Ordered](t testing.TB, actual T, minValue T, maxValue T, opts ...Option) {
+func BeInRange[T Ordered](t testing.TB, actual T, minValue T, maxValue T, opts ...Option) bool {
 	t.Helper()
 	if actual >= minValue && actual <= maxValue {
-		return
+		return true
 	}
 
 	cfg := processOptions(opts...)
 	errorMsg := formatRangeError(actual, minValue, maxValue)
 
 	failWithOptions(t, cfg, errorMsg)
+	return false
 }
 
 // BeSorted reports a test failure if the slice is not sorted in ascending order.
@@ -570,17 +608,18 @@ func BeInRange[T Ordered](t testing.TB, actual T, minValue T, maxValue T, opts .
 //	should.BeSorted(t, myArray[:]) // for arrays
 //
 // Only works with slices of ordered types (cmp.Ordered constraint).
-func BeSorted[T Sortable](t testing.TB, actual []T, opts ...Option) {
+func BeSorted[T Sortable](t testing.TB, actual []T, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
 	result := checkIfSorted(actual)
 	if result.IsSorted {
-		return
+		return true
 	}
 
 	errorMsg := formatSortError(result)
 	failWithOptions(t, cfg, errorMsg)
+	return false
 }
 
 // BeSameTime reports a test failure if two `time.Time` values do not represent the same time.
@@ -603,7 +642,7 @@ func BeSorted[T Sortable](t testing.TB, actual []T, opts ...Option) {
 //	    should.WithIgnoreTimezone(),
 //	    should.WithTruncate(time.Second),
 //	)
-func BeSameTime(t testing.TB, actual, expected time.Time, opts ...Option) {
+func BeSameTime(t testing.TB, actual, expected time.Time, opts ...Option) bool {
 	t.Helper()
 	cfg := processOptions(opts...)
 
@@ -618,7 +657,7 @@ func BeSameTime(t testing.TB, actual, expected time.Time, opts ...Option) {
 	}
 
 	if actual.Equal(expected) {
-		return
+		return true
 	}
 
 	diff := actual.Sub(expected)
@@ -626,13 +665,21 @@ func BeSameTime(t testing.TB, actual, expected time.Time, opts ...Option) {
 	errorMsg := formatBeSameTimeError(expected, actual, diff)
 
 	failWithOptions(t, cfg, errorMsg)
+	return false
 }
 
 // BeEqual reports a test failure if the two values are not deeply equal.
 //
 // Uses reflect.DeepEqual for comparison. For primitive types (string, int, float, bool, etc.),
 // it shows a simple message. For complex objects (structs, slices, maps), it shows
-// field-by-field differences.
+// field-by-field differences. Strings that span several lines, or are 280 characters
+// or longer, are shown as a unified line diff; other strings get a marker under the
+// first differing character. Byte slices and arrays, including json.RawMessage, are
+// shown as a side-by-side hexdump of the differing rows.
+//
+// Values whose type has an Equal method with the signature func(T) bool, such as
+// time.Time or net.IP, are compared with that method, wherever they appear.
+// Use WithComparer to provide the equality function for types you do not own.
 //
 // Example:
 //
@@ -642,8 +689,14 @@ func BeSameTime(t testing.TB, actual, expected time.Time, opts ...Option) {
 //
 //	should.BeEqual(t, user, expectedUser, should.WithMessage("User objects should match"))
 //
+//	should.BeEqual(t, user, expectedUser, should.WithIgnoreFields("ID", "Meta.CreatedAt"))
+//
+//	should.BeEqual(t, order, expectedOrder, should.WithComparer(decimal.Decimal.Equal))
+//
+//	should.BeEqual(t, order, expectedOrder, should.WithGoLiteral())
+//
 // Works with any comparable types. Uses deep comparison for complex objects.
-func BeEqual(t testing.TB, actual any, expected any, opts ...Option) {
+func BeEqual(t testing.TB, actual any, expected any, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
@@ -652,19 +705,64 @@ func BeEqual(t testing.TB, actual any, expected any, opts ...Option) {
 		customMsg += "\n"
 	}
 
+	actual, expected = scrubValue(cfg, actual), scrubValue(cfg, expected)
 	if reflect.DeepEqual(actual, expected) {
-		return
+		return true
+	}
+
+	diffs := findDifferencesWithConfig(expected, actual, cfg)
+	if len(diffs) == 0 && relaxesEquality(cfg, expected, actual) {
+		return true
+	}
+
+	// The actual value as Go source, to paste into the test as the expected value
+	var literal string
+	if cfg.GoLiteral {
+		source, err := formatGoLiteral(actual, callerPackage(), cfg.UnexportedFields)
+		if err != nil {
+			literal = fmt.Sprintf("Actual as Go literal: not available, %v", err)
+		} else {
+			literal = "Actual as Go literal:\n" + source
+		}
+	}
+	withLiteral := func(message string) string {
+		if literal == "" {
+			return message
+		}
+		return message + "\n\n" + literal
 	}
 
 	actualValue := reflect.ValueOf(actual)
 	expectedValue := reflect.ValueOf(expected)
 
-	actualType := actualValue.Type()
-	expectedType := expectedValue.Type()
+	actualType := reflect.TypeOf(actual)
+	expectedType := reflect.TypeOf(expected)
 	typesAreDifferent := actualType != expectedType
 
+	// Byte sequences are shown as a hexdump
+	if expectedBytes, ok := byteSequence(expected); ok && !typesAreDifferent {
+		actualBytes, _ := byteSequence(actual)
+		if firstByteDifference(expectedBytes, actualBytes) < 0 {
+			// The same bytes only differ when one slice is nil and the other is empty
+			fail(t, "%s", withLiteral(fmt.Sprintf("%sNot equal:\nexpected: %s\nactual  : %s",
+				customMsg, describeEmptySlice(expectedValue), describeEmptySlice(actualValue))))
+			return false
+		}
+		fail(t, "%s", withLiteral(customMsg+"Not equal:\n"+formatHexDiff(expectedBytes, actualBytes)))
+		return false
+	}
+
 	// For primitive types, handle type differences specially
 	if isPrimitive(actualValue.Kind()) && isPrimitive(expectedValue.Kind()) {
+		// Multi-line and long strings are shown as a line diff
+		if !typesAreDifferent && actualValue.Kind() == reflect.String &&
+			needsLineDiff(expectedValue.String(), actualValue.String()) {
+			fail(t, "%s", withLiteral(customMsg+"Not equal:\n"+
+				formatLineDiff(expectedValue.String(), actualValue.String())+
+				lookAlikeEqualityNote(expectedValue.String(), actualValue.String())))
+			return false
+		}
+
 		message := fmt.Sprintf(
 			"%sNot equal:\nexpected: %v\nactual  : %v",
 			customMsg,
@@ -674,35 +772,46 @@ func BeEqual(t testing.TB, actual any, expected any, opts ...Option) {
 
 		if typesAreDifferent {
 			message += fmt.Sprintf("\nField differences:\n  └─ : %s ≠ %s", expectedType, actualType)
+		} else if actualValue.Kind() == reflect.String {
+			message += formatCharDiff(expectedValue.String(), actualValue.String())
+			message += lookAlikeEqualityNote(expectedValue.String(), actualValue.String())
 		}
 
-		fail(t, message)
-		return
+		fail(t, "%s", withLiteral(message))
+		return false
 	}
 
-	diffs := findDifferences(expected, actual)
-
 	var differences []string
-	differencesOutput := "Field differences:\n"
-	for _, diff := range diffs {
-		if diff.Message != "" {
-			differencesOutput += fmt.Sprintf("  └─ %s: %s\n", diff.Path, diff.Message)
-			continue
-		}
-		differencesOutput += fmt.Sprintf("  └─ %s: %s ≠ %s\n", diff.Path, formatDiffValue(diff.Expected), formatDiffValue(diff.Actual))
+	differencesOutput := formatFieldDiffs(diffs)
+	if len(diffs) == 0 {
+		differencesOutput += "  └─ the values are not deeply equal, but no difference could be located\n"
 	}
 
 	message := fmt.Sprintf(
 		"%sNot equal:\nexpected: %v\nactual  : %v",
 		customMsg,
-		formatComparisonValue(expected),
-		formatComparisonValue(actual),
+		formatPrettyValue(expected),
+		formatPrettyValue(actual),
 	)
 
 	differences = append(differences, message, differencesOutput)
 
+	// Byte sequences found in nested fields are shown as hexdumps after the differences
+	for _, diff := range diffs {
+		expectedBytes, isBytes := byteSequence(diff.Expected)
+		actualBytes, _ := byteSequence(diff.Actual)
+		if isBytes && diff.Message != "" {
+			differences = append(differences, fmt.Sprintf("%s: %s\n", diff.Path, formatHexDiff(expectedBytes, actualBytes)))
+		}
+	}
+
+	if literal != "" {
+		differences = append(differences, literal+"\n")
+	}
+
 	diffMessage := strings.Join(differences, "\n")
 	fail(t, "Differences found:\n%s", diffMessage)
+	return false
 }
 
 // NotBeEqual reports a test failure if the two values are deeply equal.
@@ -718,16 +827,18 @@ func BeEqual(t testing.TB, actual any, expected any, opts ...Option) {
 //	should.NotBeEqual(t, 42, 43)
 //
 //	should.NotBeEqual(t, user, expectedUser, should.WithMessage("User objects should not match"))
-func NotBeEqual(t testing.TB, actual any, expected any, opts ...Option) {
+func NotBeEqual(t testing.TB, actual any, expected any, opts ...Option) bool {
 	t.Helper()
-	if reflect.DeepEqual(actual, expected) {
-		cfg := processOptions(opts...)
-
+	cfg := processOptions(opts...)
+	if reflect.DeepEqual(actual, expected) ||
+		(relaxesEquality(cfg, expected, actual) && len(findDifferencesWithConfig(expected, actual, cfg)) == 0) {
 		// TODO: We could enrich the error message to show that the values are unexpectedly equal
 
 		errorMsg := "Expected values to be different, but they are equal"
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // Contain reports a test failure if the slice or array does not contain the expected value.
@@ -749,11 +860,24 @@ func NotBeEqual(t testing.TB, actual any, expected any, opts ...Option) {
 //	should.Contain(t, []string{"apple", "banana"}, "apple")
 //
 // If the input is not a slice or array, the test fails immediately.
-func Contain(t testing.TB, actual any, expected any, opts ...Option) {
+func Contain(t testing.TB, actual any, expected any, opts ...Option) bool {
 	t.Helper()
 	if !isSliceOrArray(actual) {
 		fail(t, "expected a slice or array, but got %T", actual)
-		return
+		return false
+	}
+
+	// Handle matchers by explaining why the closest element was rejected
+	if matcher, ok := expected.(Matcher); ok {
+		labels, values := sliceElements(reflect.ValueOf(actual))
+		if matchesAny(matcher, values) {
+			return true
+		}
+		cfg := processOptions(opts...)
+		errorMsg := formatMatcherError("Expected collection to contain element matching:", "Collection",
+			formatSlice(actual), matcher, labels, values)
+		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
 
 	// Handle string slices with intelligent similarity detection
@@ -761,12 +885,12 @@ func Contain(t testing.TB, actual any, expected any, opts ...Option) {
 		if target, ok := expected.(string); ok {
 			result := containsString(target, collection)
 			if result.Found {
-				return
+				return true
 			}
 			cfg := processOptions(opts...)
 			errorMsg := formatContainsError(target, result)
 			failWithOptions(t, cfg, errorMsg)
-			return
+			return false
 		}
 	}
 
@@ -778,18 +902,18 @@ func Contain(t testing.TB, actual any, expected any, opts ...Option) {
 	if isNumericType(elemType) {
 		found, output := handleNumericSliceContain(actual, expected)
 		if found {
-			return
+			return true
 		}
 		cfg := processOptions(opts...)
 		failWithOptions(t, cfg, output)
-		return
+		return false
 	}
 
 	// Generic fallback for other types
 	for i := range actualValue.Len() {
 		item := actualValue.Index(i).Interface()
 		if reflect.DeepEqual(item, expected) {
-			return
+			return true
 		}
 	}
 
@@ -799,6 +923,7 @@ func Contain(t testing.TB, actual any, expected any, opts ...Option) {
 		formatSlice(actual), formatComparisonValue(expected))
 
 	failWithOptions(t, cfg, baseMsg)
+	return false
 }
 
 // ContainKey reports a test failure if the map does not contain the expected key.
@@ -815,17 +940,18 @@ func Contain(t testing.TB, actual any, expected any, opts ...Option) {
 //	should.ContainKey(t, userMap, "email")
 //
 //	should.ContainKey(t, map[int]string{1: "one", 2: "two"}, 3, should.WithMessage("Key must exist"))
-func ContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K, opts ...Option) {
+func ContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K, opts ...Option) bool {
 	t.Helper()
 
 	result := containsMapKey(actual, expectedKey)
 	if result.Found {
-		return
+		return true
 	}
 
 	cfg := processOptions(opts...)
 	errorMsg := formatMapContainKeyError(expectedKey, result)
 	failWithOptions(t, cfg, errorMsg)
+	return false
 }
 
 // ContainValue reports a test failure if the map does not contain the expected value.
@@ -834,7 +960,7 @@ func ContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K
 // - For string values: Shows similar values and typo detection
 // - For numeric values: Shows similar values with numeric differences
 // - For other types: Shows formatted values with clear error messages
-// Supports all map types.
+// Supports all map types. To match the values against a Matcher, use ContainValueMatching.
 //
 // Example:
 //
@@ -842,17 +968,41 @@ func ContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K
 //	should.ContainValue(t, userMap, 3)
 //
 //	should.ContainValue(t, map[int]string{1: "one", 2: "two"}, "three", should.WithMessage("Value must exist"))
-func ContainValue[K comparable, V any](t testing.TB, actual map[K]V, expectedValue V, opts ...Option) {
+func ContainValue[K comparable, V any](t testing.TB, actual map[K]V, expectedValue V, opts ...Option) bool {
 	t.Helper()
 
 	result := containsMapValue(actual, expectedValue)
 	if result.Found {
-		return
+		return true
 	}
 
 	cfg := processOptions(opts...)
 	errorMsg := formatMapContainValueError(expectedValue, result)
 	failWithOptions(t, cfg, errorMsg)
+	return false
+}
+
+// ContainValueMatching reports a test failure if no value of the map satisfies the matcher.
+//
+// The failure message shows the value that came closest to matching and explains why
+// it did not match.
+//
+// Example:
+//
+//	should.ContainValueMatching(t, ages, should.GreaterOrEqualTo(18))
+func ContainValueMatching[K comparable, V any](t testing.TB, actual map[K]V, matcher Matcher, opts ...Option) bool {
+	t.Helper()
+
+	labels, values := mapElements(reflect.ValueOf(actual))
+	if matchesAny(matcher, values) {
+		return true
+	}
+
+	cfg := processOptions(opts...)
+	errorMsg := formatMatcherError("Expected map to contain value matching:", "Map",
+		formatComparisonValue(actual), matcher, labels, values)
+	failWithOptions(t, cfg, errorMsg)
+	return false
 }
 
 // NotContain reports a test failure if the slice or array contains the expected value.
@@ -869,15 +1019,16 @@ func ContainValue[K comparable, V any](t testing.TB, actual map[K]V, expectedVal
 //	should.NotContain(t, []string{"apple", "banana"}, "orange", should.WithMessage("Should not have orange"))
 //
 // If the input is not a slice or array, the test fails immediately.
-func NotContain(t testing.TB, actual any, expected any, opts ...Option) {
+func NotContain(t testing.TB, actual any, expected any, opts ...Option) bool {
 	t.Helper()
 	if !isSliceOrArray(actual) {
 		fail(t, "expected a slice or array, but got %T", actual)
-		return
+		return false
 	}
 
 	actualValue := reflect.ValueOf(actual)
 
+	passed := true
 	foundOutput := []string{}
 	for i := range actualValue.Len() {
 		item := actualValue.Index(i).Interface()
@@ -889,8 +1040,10 @@ func NotContain(t testing.TB, actual any, expected any, opts ...Option) {
 			cfg := processOptions(opts...)
 			errorMsg := fmt.Sprintf("\nExpected collection to NOT contain element: %s", output)
 			failWithOptions(t, cfg, errorMsg)
+			passed = false
 		}
 	}
+	return passed
 }
 
 // NotContainDuplicates reports a test failure if the slice or array contains duplicate values.
@@ -905,11 +1058,11 @@ func NotContain(t testing.TB, actual any, expected any, opts ...Option) {
 //	should.NotContainDuplicates(t, []string{"John", "John"})
 //
 // If the input is not a slice or array, the test fails immediately.
-func NotContainDuplicates(t testing.TB, actual any, opts ...Option) {
+func NotContainDuplicates(t testing.TB, actual any, opts ...Option) bool {
 	t.Helper()
 	if !isSliceOrArray(actual) {
 		fail(t, "expected a slice or array, but got %T", actual)
-		return
+		return false
 	}
 
 	collection := reflect.ValueOf(actual).Interface()
@@ -920,13 +1073,13 @@ func NotContainDuplicates(t testing.TB, actual any, opts ...Option) {
 	customMsg := cfg.Message
 
 	if len(duplicates) == 0 {
-		return
+		return true
 	}
 
 	if customMsg != "" {
 		if len(duplicates) == 1 {
 			fail(t, "%s\nExpected no duplicates, but found 1 duplicate value: %s", customMsg, formatDuplicatesErrors(duplicates))
-			return
+			return false
 		}
 
 		fail(
@@ -936,15 +1089,16 @@ func NotContainDuplicates(t testing.TB, actual any, opts ...Option) {
 			len(duplicates),
 			formatDuplicatesErrors(duplicates),
 		)
-		return
+		return false
 	}
 
 	if len(duplicates) == 1 {
 		fail(t, "%s\nExpected no duplicates, but found 1 duplicate value: %s", customMsg, formatDuplicatesErrors(duplicates))
-		return
+		return false
 	}
 
 	fail(t, "Expected no duplicates, but found %d duplicate values: %s", len(duplicates), formatDuplicatesErrors(duplicates))
+	return false
 }
 
 // NotContainKey reports a test failure if the map contains the expected key.
@@ -959,7 +1113,7 @@ func NotContainDuplicates(t testing.TB, actual any, opts ...Option) {
 //	should.NotContainKey(t, userMap, "age") // This will fail
 //
 //	should.NotContainKey(t, map[int]string{1: "one", 2: "two"}, 3, should.WithMessage("Key should not exist"))
-func NotContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K, opts ...Option) {
+func NotContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K, opts ...Option) bool {
 	t.Helper()
 
 	result := containsMapKey(actual, expectedKey)
@@ -967,7 +1121,9 @@ func NotContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKe
 		cfg := processOptions(opts...)
 		errorMsg := formatMapNotContainKeyError(expectedKey, actual)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // NotContainValue reports a test failure if the map contains the expected value.
@@ -982,7 +1138,7 @@ func NotContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKe
 //	should.NotContainValue(t, userMap, 2) // This will fail
 //
 //	should.NotContainValue(t, map[int]string{1: "one", 2: "two"}, "three", should.WithMessage("Value should not exist"))
-func NotContainValue[K comparable, V any](t testing.TB, actual map[K]V, expectedValue V, opts ...Option) {
+func NotContainValue[K comparable, V any](t testing.TB, actual map[K]V, expectedValue V, opts ...Option) bool {
 	t.Helper()
 
 	result := containsMapValue(actual, expectedValue)
@@ -990,14 +1146,40 @@ func NotContainValue[K comparable, V any](t testing.TB, actual map[K]V, expected
 		cfg := processOptions(opts...)
 		errorMsg := formatMapNotContainValueError(expectedValue, actual)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
+}
+
+// NotContainValueMatching reports a test failure if any value of the map satisfies the
+// matcher.
+//
+// The failure message lists the values that matched.
+//
+// Example:
+//
+//	should.NotContainValueMatching(t, ages, should.LessThan(0))
+func NotContainValueMatching[K comparable, V any](t testing.TB, actual map[K]V, matcher Matcher, opts ...Option) bool {
+	t.Helper()
+
+	labels, values := mapElements(reflect.ValueOf(actual))
+	if !matchesAny(matcher, values) {
+		return true
+	}
+
+	cfg := processOptions(opts...)
+	errorMsg := formatMatchFoundError("Expected map to NOT contain value matching:", "Map",
+		formatComparisonValue(actual), matcher, labels, values)
+	failWithOptions(t, cfg, errorMsg)
+	return false
 }
 
 // AnyMatch reports a test failure if no element in the slice matches the predicate function.
 //
 // This assertion allows custom matching logic by providing a predicate function
 // that will be called for each element in the slice. The test passes if any element
-// makes the predicate return true.
+// makes the predicate return true. To match elements against a Matcher, use AnyMatching,
+// which also explains why the closest element did not match.
 //
 // Example:
 //
@@ -1012,16 +1194,40 @@ func NotContainValue[K comparable, V any](t testing.TB, actual map[K]V, expected
 //	should.AnyMatch(t, numbers, func(n int) bool {
 //		return n%2 == 0
 //	}, should.WithMessage("No even numbers found"))
-func AnyMatch[T any](t testing.TB, actual []T, predicate func(T) bool, opts ...Option) {
+func AnyMatch[T any](t testing.TB, actual []T, predicate func(T) bool, opts ...Option) bool {
 	t.Helper()
 
 	if slices.ContainsFunc(actual, predicate) {
-		return
+		return true
 	}
 
 	cfg := processOptions(opts...)
 	errorMsg := "\nPredicate does not match any item in the slice"
 	failWithOptions(t, cfg, errorMsg)
+	return false
+}
+
+// AnyMatching reports a test failure if no element in the slice satisfies the matcher.
+//
+// It is the Matcher counterpart of AnyMatch: the failure message shows the element that
+// came closest to matching and explains why it did not match.
+//
+// Example:
+//
+//	should.AnyMatching(t, users, should.HasField("Age", should.GreaterOrEqualTo(18)))
+func AnyMatching[T any](t testing.TB, actual []T, matcher Matcher, opts ...Option) bool {
+	t.Helper()
+
+	labels, values := sliceElements(reflect.ValueOf(actual))
+	if matchesAny(matcher, values) {
+		return true
+	}
+
+	cfg := processOptions(opts...)
+	errorMsg := formatMatcherError("Expected at least one item to match:", "Collection",
+		formatSlice(actual), matcher, labels, values)
+	failWithOptions(t, cfg, errorMsg)
+	return false
 }
 
 // StartWith reports a test failure if the string does not start with the expected substring.
@@ -1039,13 +1245,18 @@ func AnyMatch[T any](t testing.TB, actual []T, predicate func(T) bool, opts ...O
 //	should.StartWith(t, "Hello, world!", "world", should.WithMessage("Expected string to start with 'world'"))
 //
 // Note: The assertion is case-sensitive by default. Use should.WithIgnoreCase() to ignore case.
-func StartWith(t testing.TB, actual string, expected string, opts ...Option) {
+func StartWith(t testing.TB, actual string, expected string, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
 
 	if actual == expected || (cfg.IgnoreCase && strings.HasPrefix(strings.ToLower(actual), strings.ToLower(expected))) {
-		return
+		return true
+	}
+
+	lookAlikeNote := ""
+	if strings.HasPrefix(lookAlikeSkeleton(actual), lookAlikeSkeleton(expected)) {
+		lookAlikeNote = formatLookAlikeNote([]string{"prefix", "actual"}, []string{expected, actual})
 	}
 
 	if strings.TrimSpace(actual) == "" {
@@ -1076,11 +1287,14 @@ func StartWith(t testing.TB, actual string, expected string, opts ...Option) {
 	if !cfg.IgnoreCase && strings.HasPrefix(strings.ToLower(actual), strings.ToLower(expected)) {
 		noteMsg = "\nNote: Case mismatch detected (use should.WithIgnoreCase() if intended)"
 	}
+	noteMsg += lookAlikeNote
 
 	errorMsg := formatStartsWithError(actual, expected, startWith, noteMsg, cfg)
 	if errorMsg != "" {
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // EndWith reports a test failure if the string does not end with the expected substring.
@@ -1098,7 +1312,7 @@ func StartWith(t testing.TB, actual string, expected string, opts ...Option) {
 //	should.EndWith(t, "Hello, world!", "world", should.WithMessage("Expected string to end with 'world'"))
 //
 // Note: The assertion is case-sensitive by default. Use should.WithIgnoreCase() to ignore case.
-func EndWith(t testing.TB, actual string, expected string, opts ...Option) {
+func EndWith(t testing.TB, actual string, expected string, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
@@ -1112,7 +1326,7 @@ func EndWith(t testing.TB, actual string, expected string, opts ...Option) {
 	}
 
 	if actual == expected || (cfg.IgnoreCase && strings.HasPrefix(strings.ToLower(actualEndSufix), strings.ToLower(expected))) {
-		return
+		return true
 	}
 
 	if strings.TrimSpace(actual) == "" {
@@ -1139,7 +1353,9 @@ func EndWith(t testing.TB, actual string, expected string, opts ...Option) {
 	errorMsg := formatEndsWithError(actual, expected, actualEndSufix, noteMsg, cfg)
 	if errorMsg != "" {
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // ContainSubstring reports a test failure if the string does not contain the expected substring.
@@ -1158,10 +1374,11 @@ func EndWith(t testing.TB, actual string, expected string, opts ...Option) {
 //	should.ContainSubstring(t, longText, "keyword", should.WithMessage("Expected keyword to be present"))
 //
 // Note: The assertion is case-sensitive by default. Use should.WithIgnoreCase() to ignore case.
-func ContainSubstring(t testing.TB, actual string, substring string, opts ...Option) {
+func ContainSubstring(t testing.TB, actual string, substring string, opts ...Option) bool {
 	t.Helper()
 
 	cfg := processOptions(opts...)
+	actual, substring = scrubString(cfg, actual), scrubString(cfg, substring)
 
 	found := strings.Contains(actual, substring)
 	if !found && cfg.IgnoreCase {
@@ -1169,7 +1386,7 @@ func ContainSubstring(t testing.TB, actual string, substring string, opts ...Opt
 	}
 
 	if found {
-		return
+		return true
 	}
 
 	// Check for exact case mismatch first (only when ignoreCase is false)
@@ -1186,7 +1403,7 @@ func ContainSubstring(t testing.TB, actual string, substring string, opts ...Opt
 				actual, substring); result.Found {
 				errorMsg := formatSimpleCaseMismatchError(substring, result.Substring, result.Index)
 				failWithOptions(t, cfg, errorMsg)
-				return
+				return false
 			}
 		}
 	}
@@ -1196,9 +1413,93 @@ func ContainSubstring(t testing.TB, actual string, substring string, opts ...Opt
 	if !cfg.IgnoreCase && hasInsensitiveMatch {
 		noteMsg = "\nNote: Case mismatch detected (use should.WithIgnoreCase() if intended)"
 	}
+	if strings.Contains(lookAlikeSkeleton(actual), lookAlikeSkeleton(substring)) {
+		noteMsg += formatLookAlikeNote([]string{"substring", "actual   "}, []string{substring, actual})
+	}
 
 	errorMsg := formatContainSubstringError(actual, substring, noteMsg)
 	failWithOptions(t, cfg, errorMsg)
+	return false
+}
+
+// MatchRegex reports a test failure if the string does not match the regular expression.
+//
+// The pattern can be a string or a *regexp.Regexp. As with regexp.MatchString, a match
+// anywhere in the string is enough; anchor the pattern with ^ and $ to match it whole.
+// On failure, the message shows the longest prefix of the pattern that matches, where in
+// the string that match stopped, and the named groups it captured, even partially.
+//
+// Example:
+//
+//	should.MatchRegex(t, id, `^usr_[a-z0-9]{12}$`)
+//
+//	should.MatchRegex(t, version, semverPattern, should.WithMessage("invalid version"))
+func MatchRegex(t testing.TB, actual string, pattern any, opts ...Option) bool {
+	t.Helper()
+
+	_, ok := matchRegex(t, "MatchRegex", actual, pattern, opts...)
+	return ok
+}
+
+// MatchRegexSubmatches reports a test failure if the string does not match the regular
+// expression, like MatchRegex, and returns the text of the leftmost match followed by the
+// text of its capture groups, as regexp.Regexp.FindStringSubmatch does.
+//
+// When the string does not match, the result still has one empty string for the match and
+// for each group, so that indexing it does not panic after the reported failure. It is nil
+// only when the pattern itself is invalid.
+//
+// Example:
+//
+//	groups := should.MatchRegexSubmatches(t, location, `^/orders/(\d+)$`)
+//	should.BeEqual(t, groups[1], "42")
+func MatchRegexSubmatches(t testing.TB, actual string, pattern any, opts ...Option) ([]string, bool) {
+	t.Helper()
+
+	return matchRegex(t, "MatchRegexSubmatches", actual, pattern, opts...)
+}
+
+// matchRegex checks that actual matches pattern for the regex assertion called name.
+func matchRegex(t testing.TB, name string, actual string, pattern any, opts ...Option) ([]string, bool) {
+	t.Helper()
+
+	cfg := processOptions(opts...)
+	re, err := compileRegex(pattern)
+	if err != nil {
+		failWithOptions(t, cfg, "%s: %v", name, err)
+		return nil, false
+	}
+
+	if submatches := re.FindStringSubmatch(actual); submatches != nil {
+		return submatches, true
+	}
+	failWithOptions(t, cfg, "%s", formatRegexMismatch(re, actual))
+	return make([]string, re.NumSubexp()+1), false
+}
+
+// NotMatchRegex reports a test failure if the string matches the regular expression.
+//
+// The pattern can be a string or a *regexp.Regexp, and a match anywhere in the string
+// counts. On failure, the message shows the first match and its position.
+//
+// Example:
+//
+//	should.NotMatchRegex(t, logLine, `(?i)password=\S+`)
+func NotMatchRegex(t testing.TB, actual string, pattern any, opts ...Option) bool {
+	t.Helper()
+
+	cfg := processOptions(opts...)
+	re, err := compileRegex(pattern)
+	if err != nil {
+		failWithOptions(t, cfg, "NotMatchRegex: %v", err)
+		return false
+	}
+
+	if !re.MatchString(actual) {
+		return true
+	}
+	failWithOptions(t, cfg, "%s", formatRegexMatch(re, actual))
+	return false
 }
 
 // HaveLength reports a test failure if the collection does not have the expected length.
@@ -1211,7 +1512,7 @@ func ContainSubstring(t testing.TB, actual string, substring string, opts ...Opt
 //
 //	should.HaveLength(t, []int{1, 2, 3}, 3)
 //	should.HaveLength(t, "hello", 5)
-func HaveLength(t testing.TB, actual any, expected int, opts ...Option) {
+func HaveLength(t testing.TB, actual any, expected int, opts ...Option) bool {
 	t.Helper()
 	v := reflect.ValueOf(actual)
 	var actualLen int
@@ -1221,14 +1522,16 @@ func HaveLength(t testing.TB, actual any, expected int, opts ...Option) {
 		actualLen = v.Len()
 	default:
 		fail(t, "HaveLength can only be used with types that have a concept of length (string, slice, array, map), but got %T", actual)
-		return
+		return false
 	}
 
 	if actualLen != expected {
 		cfg := processOptions(opts...)
 		errorMsg := formatLengthError(actual, expected, actualLen)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeOfType reports a test failure if the value is not of the expected type.
@@ -1241,7 +1544,7 @@ func HaveLength(t testing.TB, actual any, expected int, opts ...Option) {
 //	type MyType struct{}
 //	var v MyType
 //	should.BeOfType(t, MyType{}, v)
-func BeOfType(t testing.TB, actual, expected any, opts ...Option) {
+func BeOfType(t testing.TB, actual, expected any, opts ...Option) bool {
 	t.Helper()
 	expectedType := reflect.TypeOf(expected)
 	actualType := reflect.TypeOf(actual)
@@ -1250,35 +1553,52 @@ func BeOfType(t testing.TB, actual, expected any, opts ...Option) {
 		cfg := processOptions(opts...)
 		errorMsg := formatTypeError(expectedType, actualType)
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // BeOneOf reports a test failure if the value is not one of the provided options.
 //
 // This assertion checks if the actual value is present in the slice of allowed options.
-// It uses deep comparison to check for equality.
+// It uses deep comparison to check for equality. When the options are of an interface
+// type, they may also contain matchers, which are checked with Match instead.
 //
 // Example:
 //
 //	status := "pending"
 //	allowedStatus := []string{"active", "inactive"}
 //	should.BeOneOf(t, status, allowedStatus)
-func BeOneOf[T any](t testing.TB, actual T, options []T, opts ...Option) {
+//
+//	should.BeOneOf[any](t, age, []any{0, should.GreaterOrEqualTo(18)})
+func BeOneOf[T any](t testing.TB, actual T, options []T, opts ...Option) bool {
 	t.Helper()
 	if len(options) == 0 {
 		fail(t, "Options list cannot be empty for BeOneOf assertion")
-		return
+		return false
 	}
 
+	hasMatchers := false
 	for _, opt := range options {
+		if matcher, ok := any(opt).(Matcher); ok {
+			hasMatchers = true
+			if matcher.Match(actual) {
+				return true
+			}
+			continue
+		}
 		if reflect.DeepEqual(actual, opt) {
-			return
+			return true
 		}
 	}
 
 	cfg := processOptions(opts...)
 	errorMsg := formatOneOfError(actual, options)
+	if hasMatchers {
+		errorMsg = formatOneOfMatcherError(actual, options)
+	}
 	failWithOptions(t, cfg, errorMsg)
+	return false
 }
 
 // Panic reports a test failure if the given function does not panic.
@@ -1298,14 +1618,16 @@ func BeOneOf[T any](t testing.TB, actual T, options []T, opts ...Option) {
 //	}, should.WithMessage("Division by zero should panic"))
 //
 // The function parameter must not be nil.
-func Panic(t testing.TB, fn func(), opts ...Option) {
+func Panic(t testing.TB, fn func(), opts ...Option) bool {
 	t.Helper()
 	cfg := processOptions(opts...)
 	panicInfo := didPanic(fn)
 	if !panicInfo.Panicked {
 		errorMsg := "Expected panic, but did not panic"
 		failWithOptions(t, cfg, errorMsg)
+		return false
 	}
+	return true
 }
 
 // NotPanic reports a test failure if the given function panics.
@@ -1328,7 +1650,7 @@ func Panic(t testing.TB, fn func(), opts ...Option) {
 // Note: Stack trace is not available when should.WithStackTrace() is not used
 //
 // The function parameter must not be nil.
-func NotPanic(t testing.TB, fn func(), opts ...Option) {
+func NotPanic(t testing.TB, fn func(), opts ...Option) bool {
 	t.Helper()
 	cfg := processOptions(opts...)
 	panicInfo := didPanic(fn)
@@ -1336,7 +1658,229 @@ func NotPanic(t testing.TB, fn func(), opts ...Option) {
 		errorMsg := formatNotPanicError(panicInfo, cfg)
 
 		failWithOptions(t, cfg, errorMsg)
+		return false
+	}
+	return true
+}
+
+// Eventually reports a test failure if the assertions in fn do not pass within the timeout.
+//
+// fn is run repeatedly against a capturing testing.TB, waiting interval between attempts,
+// until an attempt records no failure or the timeout elapses. On timeout, the failures
+// captured on the last completed attempt are reported together with the number of
+// attempts made.
+//
+// Each attempt runs in its own goroutine, so an attempt that blocks does not hold up the
+// timeout: Eventually waits for it until the timeout and then gives up and leaves it
+// running.
+//
+// Example:
+//
+//	should.Eventually(t, func(c testing.TB) {
+//		should.BeEqual(c, job.Status(), "done")
+//	}, 2*time.Second, 50*time.Millisecond)
+func Eventually(t testing.TB, fn func(c testing.TB), timeout, interval time.Duration, opts ...Option) bool {
+	t.Helper()
+
+	if timeout <= 0 || interval <= 0 {
+		fail(t, "Timeout and interval must be positive, got timeout=%v, interval=%v", timeout, interval)
+		return false
+	}
+
+	start := time.Now()
+	deadline := start.Add(timeout)
+	var last []groupFailure
+	for attempts := 1; ; attempts++ {
+		failures, returned := runAttemptFor(t, fn, time.Until(deadline))
+		if returned {
+			if len(failures) == 0 {
+				return true
+			}
+			last = failures
+		}
+
+		if !waitForNextAttempt(deadline, interval) {
+			cfg := processOptions(opts...)
+			failWithOptions(t, cfg, formatEventuallyError(timeout, time.Since(start), attempts, last, !returned))
+			return false
+		}
+	}
+}
+
+// Consistently reports a test failure if the assertions in fn stop passing at any point
+// during the given duration.
+//
+// fn is run repeatedly against a capturing testing.TB, waiting interval between attempts,
+// until the duration elapses. The first attempt that records a failure ends the check,
+// and its failures are reported together with the number of attempts made.
+//
+// Each attempt runs in its own goroutine, as in Eventually. An attempt that is still
+// running when the duration elapses counts as a failure, since it never showed that the
+// condition held.
+//
+// Example:
+//
+//	should.Consistently(t, func(c testing.TB) {
+//		should.BeEqual(c, cache.Len(), 10)
+//	}, time.Second, 100*time.Millisecond)
+func Consistently(t testing.TB, fn func(c testing.TB), duration, interval time.Duration, opts ...Option) bool {
+	t.Helper()
+
+	if duration <= 0 || interval <= 0 {
+		fail(t, "Duration and interval must be positive, got duration=%v, interval=%v", duration, interval)
+		return false
+	}
+
+	start := time.Now()
+	deadline := start.Add(duration)
+	for attempts := 1; ; attempts++ {
+		failures, returned := runAttemptFor(t, fn, time.Until(deadline))
+		if !returned || len(failures) > 0 {
+			cfg := processOptions(opts...)
+			failWithOptions(t, cfg, formatConsistentlyError(duration, time.Since(start), attempts, failures, !returned))
+			return false
+		}
+
+		if !waitForNextAttempt(deadline, interval) {
+			return true
+		}
+	}
+}
+
+// MatchJSON reports a test failure if two JSON documents are not structurally equal.
+//
+// Both documents can be given as a string, as a []byte such as json.RawMessage, or as any
+// other value, which is marshaled to JSON first. Key order and whitespace are ignored and
+// numbers are compared by value, so 1 and 1.0 are equal. Differences are reported as JSON
+// paths, such as "$.items[2].price: 10 ≠ 12".
+//
+// Example:
+//
+//	should.MatchJSON(t, resp.Body, `{"id": 1, "tags": ["a", "b"]}`)
+//
+//	should.MatchJSON(t, resp.Body, expectedUser, should.WithIgnoreExtraFields())
+func MatchJSON(t testing.TB, actual any, expected any, opts ...Option) bool {
+	t.Helper()
+
+	cfg := processOptions(opts...)
+	actualDocument, err := parseJSON(actual)
+	if err != nil {
+		failWithOptions(t, cfg, "MatchJSON: actual is not valid JSON: %v", err)
+		return false
+	}
+	expectedDocument, err := parseJSON(expected)
+	if err != nil {
+		failWithOptions(t, cfg, "MatchJSON: expected is not valid JSON: %v", err)
+		return false
+	}
+
+	differ := &jsonDiffer{cfg: cfg}
+	diffs := differ.compare(expectedDocument, actualDocument, "$")
+	if len(diffs) == 0 {
+		return true
+	}
+
+	failWithOptions(t, cfg, formatJSONMismatch("Expected JSON documents to match", expectedDocument, actualDocument, diffs))
+	return false
+}
+
+// ContainJSON reports a test failure if the actual JSON document does not contain the
+// shape of the expected, partial one.
+//
+// Every key of an expected object must be present in actual with a matching value, at any
+// depth; keys that are only present in actual are ignored. Arrays must have the same
+// elements in the same order, unless WithIgnoreOrder or WithArrayPrefix says otherwise.
+// The strings "<any>", "<string>", "<number>", "<bool>", "<null>", "<object>" and
+// "<array>" in the expected document match any actual value of that kind. Documents are
+// accepted in the same forms as in MatchJSON, and missing or differing values are
+// reported as JSON paths.
+//
+// Example:
+//
+//	should.ContainJSON(t, resp.Body, `{"id": "<string>", "status": "active"}`)
+//
+//	should.ContainJSON(t, resp.Body, `{"tags": ["admin"]}`, should.WithArrayPrefix())
+func ContainJSON(t testing.TB, actual any, expected any, opts ...Option) bool {
+	t.Helper()
+
+	cfg := processOptions(opts...)
+	actualDocument, err := parseJSON(actual)
+	if err != nil {
+		failWithOptions(t, cfg, "ContainJSON: actual is not valid JSON: %v", err)
+		return false
+	}
+	expectedDocument, err := parseJSON(expected)
+	if err != nil {
+		failWithOptions(t, cfg, "ContainJSON: expected is not valid JSON: %v", err)
+		return false
+	}
+
+	cfg.IgnoreExtraFields = true
+	differ := &jsonDiffer{cfg: cfg}
+	diffs := differ.compare(withJSONPlaceholders(expectedDocument), actualDocument, "$")
+	if len(diffs) == 0 {
+		return true
+	}
+
+	failWithOptions(t, cfg, formatJSONMismatch("Expected JSON to contain", expectedDocument, actualDocument, diffs))
+	return false
+}
+
+// runAttempt runs fn once against a recorder and returns the failures it captured.
+func runAttempt(t testing.TB, fn func(c testing.TB)) []groupFailure {
+	g := newG(t, 0)
+	g.run(func(g *G) { fn(g) })
+	return g.failures
+}
+
+// attemptResult is the outcome of an attempt run by runAttemptFor.
+type attemptResult struct {
+	failures  []groupFailure
+	panicked  bool
+	recovered any
+}
+
+// runAttemptFor runs fn once in its own goroutine, like runAttempt, and waits for it for
+// at most wait. It reports whether the attempt returned in time; the failures of an
+// attempt that is still running are not known, so none are returned.
+func runAttemptFor(t testing.TB, fn func(c testing.TB), wait time.Duration) ([]groupFailure, bool) {
+	done := make(chan attemptResult, 1)
+	go func() {
+		var result attemptResult
+		defer func() {
+			if r := recover(); r != nil {
+				result.panicked, result.recovered = true, r
+			}
+			done <- result
+		}()
+		result.failures = runAttempt(t, fn)
+	}()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case result := <-done:
+		if result.panicked {
+			// Report panics on the test goroutine, as a direct call would
+			panic(result.recovered)
+		}
+		return result.failures, true
+	case <-timer.C:
+		return nil, false
+	}
+}
+
+// waitForNextAttempt sleeps for interval before the next attempt of Eventually or
+// Consistently. When the deadline comes first, it sleeps until the deadline instead and
+// reports that no attempt is left.
+func waitForNextAttempt(deadline time.Time, interval time.Duration) bool {
+	remaining := time.Until(deadline)
+	if remaining <= interval {
+		time.Sleep(remaining)
+		return false
 	}
+	time.Sleep(interval)
+	return true
 }
 
 // didPanic executes a function and reports whether it panicked, returning the recovered value.
@@ -1,9 +1,11 @@
 package assert
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -474,7 +476,7 @@ func TestBeEqual_TypeDifferences(t *testing.T) {
 				"Not equal:",
 				`expected: {Name: "João", Ages: [10, 20], Scores: [8.5, 9]}`,
 				`actual  : {Name: "João", Ages: [10, 20, 30], Scores: [8.5, 9]}`,
-				"└─ Ages: length mismatch (expected: 2, actual: 3)",
+				"└─ Ages: + [2] 30",
 			},
 		},
 		{
@@ -503,7 +505,7 @@ func TestBeEqual_TypeDifferences(t *testing.T) {
 				`expected: {Name: "Maria", Ages: [10, 20], Scores: [8.5, 9, 7]}`,
 				`actual  : {Name: "João", Ages: [10, 20, 30], Scores: [8.5, 9.5, 7]}`,
 				"└─ Name: \"Maria\" ≠ \"João\"",
-				"└─ Ages: length mismatch (expected: 2, actual: 3)",
+				"└─ Ages: + [2] 30",
 				"└─ Scores.[1]: 9 ≠ 9.5",
 			},
 		},
@@ -516,7 +518,7 @@ func TestBeEqual_TypeDifferences(t *testing.T) {
 				"Not equal:",
 				"expected: [1, 2]",
 				"actual  : [1, 2, 3]",
-				"└─ : length mismatch (expected: 2, actual: 3)",
+				"└─ : + [2] 3",
 			},
 		},
 	}
@@ -960,7 +962,7 @@ func TestShouldContain_ShowsSimilarElements_OnFailure(t *testing.T) {
 	}
 
 	expectedParts := []string{
-		"Collection: [user-one, user_two, UserThree, user-3, userThree]",
+		`Collection: ["user-one", "user_two", "UserThree", "user-3", "userThree"]`,
 		"Missing   : user3",
 		"Similar elements found:",
 		"└─ user-3 (at index 3) - 1 extra character",
@@ -3701,6 +3703,26 @@ func TestNotContainDuplicates_WithCustomMessage(t *testing.T) {
 	}
 }
 
+func TestNotContainDuplicates_ReportsGroupsInOrder(t *testing.T) {
+	t.Parallel()
+
+	for run := 0; run < 10; run++ {
+		failed, message := assertFails(t, func(t testing.TB) {
+			NotContainDuplicates(t, []string{"c", "a", "b", "a", "c", "b"})
+		})
+		if !failed {
+			t.Fatal("Expected NotContainDuplicates to fail")
+		}
+		want := `
+└─ "c" appears 2 times at indexes [0, 4]
+└─ "a" appears 2 times at indexes [1, 3]
+└─ "b" appears 2 times at indexes [2, 5]`
+		if !strings.HasSuffix(message, want) {
+			t.Fatalf("Expected duplicates in order of appearance, got:\n%s", message)
+		}
+	}
+}
+
 // === Tests for StartWith ===
 
 func TestStartsWith_WithCustomMessage(t *testing.T) {
@@ -7202,3 +7224,872 @@ func generateUnsortedLargeSlice(size int) []int {
 	}
 	return slice
 }
+
+// === Tests for Eventually ===
+
+func TestEventually_PassesOnceConditionIsMet(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	failed, message := assertFails(t, func(t testing.TB) {
+		Eventually(t, func(c testing.TB) {
+			attempts++
+			BeGreaterOrEqualTo(c, attempts, 3)
+		}, time.Second, time.Millisecond)
+	})
+
+	if failed {
+		t.Errorf("Expected Eventually to pass, but it failed with message: %q", message)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestEventually_ReportsLastFailureOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	failed, message := assertFails(t, func(t testing.TB) {
+		Eventually(t, func(c testing.TB) {
+			attempts++
+			BeEqual(c, attempts, -1)
+		}, 20*time.Millisecond, 5*time.Millisecond, WithMessage("job never finished"))
+	})
+
+	if !failed {
+		t.Fatal("Expected Eventually to fail")
+	}
+
+	expectedParts := []string{
+		"job never finished\nExpected condition to be met within 20ms, but it was not",
+		fmt.Sprintf("Attempts: %d in ", attempts),
+		"Last attempt failed with:",
+		fmt.Sprintf("expected: -1\n   actual  : %d", attempts),
+	}
+	for _, part := range expectedParts {
+		if !strings.Contains(message, part) {
+			t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+		}
+	}
+}
+
+func TestEventually_StopsWaitingForABlockedAttempt(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	start := time.Now()
+	failed, message := assertFails(t, func(t testing.TB) {
+		Eventually(t, func(c testing.TB) {
+			<-release
+		}, 20*time.Millisecond, 5*time.Millisecond)
+	})
+
+	if !failed {
+		t.Fatal("Expected Eventually to fail")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected Eventually to return at the timeout, took %v", elapsed)
+	}
+	if !strings.Contains(message, "Attempts: 1 in ") ||
+		!strings.Contains(message, "Last attempt was still running when the timeout elapsed") {
+		t.Errorf("Expected a blocked attempt to be reported, got:\n%s", message)
+	}
+}
+
+func TestEventually_ReportsLastCompletedFailureOfABlockedAttempt(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	attempts := 0
+	failed, message := assertFails(t, func(t testing.TB) {
+		Eventually(t, func(c testing.TB) {
+			attempts++
+			if attempts > 1 {
+				<-release
+			}
+			BeEqual(c, attempts, 2)
+		}, 50*time.Millisecond, 5*time.Millisecond)
+	})
+
+	if !failed {
+		t.Fatal("Expected Eventually to fail")
+	}
+	expectedParts := []string{
+		"Attempts: 2 in ",
+		"Last attempt was still running when the timeout elapsed\nLast completed attempt failed with:",
+		"expected: 2\n   actual  : 1",
+	}
+	for _, part := range expectedParts {
+		if !strings.Contains(message, part) {
+			t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+		}
+	}
+}
+
+func TestEventually_DoesNotOverrunTheTimeout(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	attempts := 0
+	start := time.Now()
+	failed, message := assertFails(t, func(t testing.TB) {
+		Eventually(t, func(c testing.TB) {
+			attempts++
+			if attempts > 1 {
+				<-release
+			}
+			BeTrue(c, false)
+		}, 50*time.Millisecond, time.Second)
+	})
+
+	if !failed {
+		t.Fatal("Expected Eventually to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected Eventually to return at the timeout, took %v", elapsed)
+	}
+	if !strings.Contains(message, "Attempts: 1 in ") || !strings.Contains(message, "Last attempt failed with:") {
+		t.Errorf("Expected only the first attempt to be reported, got:\n%s", message)
+	}
+}
+
+func TestEventually_RejectsNonPositiveDurations(t *testing.T) {
+	t.Parallel()
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		Eventually(t, func(c testing.TB) {}, 0, time.Millisecond)
+	})
+
+	if !failed || !strings.Contains(message, "must be positive") {
+		t.Errorf("Expected invalid durations to fail, got: %q", message)
+	}
+}
+
+// === Tests for Consistently ===
+
+func TestConsistently_PassesWhenConditionHolds(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	failed, message := assertFails(t, func(t testing.TB) {
+		Consistently(t, func(c testing.TB) {
+			attempts++
+			BeTrue(c, true)
+		}, 20*time.Millisecond, 5*time.Millisecond)
+	})
+
+	if failed {
+		t.Errorf("Expected Consistently to pass, but it failed with message: %q", message)
+	}
+	if attempts < 2 {
+		t.Errorf("Expected the condition to be checked repeatedly, got %d attempts", attempts)
+	}
+}
+
+func TestConsistently_FailsOnFirstBrokenAttempt(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	failed, message := assertFails(t, func(t testing.TB) {
+		Consistently(t, func(c testing.TB) {
+			attempts++
+			BeLessThan(c, attempts, 3)
+		}, time.Second, time.Millisecond)
+	})
+
+	if !failed {
+		t.Fatal("Expected Consistently to fail")
+	}
+	if attempts != 3 {
+		t.Errorf("Expected Consistently to stop on the third attempt, got %d", attempts)
+	}
+
+	expectedParts := []string{
+		"Expected condition to hold for 1s, but it failed after",
+		"Attempts: 3\nFailed with:",
+		"Expected value to be less than threshold:",
+	}
+	for _, part := range expectedParts {
+		if !strings.Contains(message, part) {
+			t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+		}
+	}
+}
+
+func TestConsistently_StopsWaitingForABlockedAttempt(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	start := time.Now()
+	failed, message := assertFails(t, func(t testing.TB) {
+		Consistently(t, func(c testing.TB) {
+			<-release
+		}, 20*time.Millisecond, 5*time.Millisecond)
+	})
+
+	if !failed {
+		t.Fatal("Expected Consistently to fail")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected Consistently to return at the end of the duration, took %v", elapsed)
+	}
+	if !strings.Contains(message, "Attempts: 1\n") ||
+		!strings.Contains(message, "Last attempt was still running when the duration elapsed") {
+		t.Errorf("Expected a blocked attempt to be reported, got:\n%s", message)
+	}
+}
+
+// === Tests for WithIgnoreFields ===
+
+func TestBeEqual_WithIgnoreFields(t *testing.T) {
+	t.Parallel()
+
+	type Item struct {
+		Name      string
+		UpdatedAt time.Time
+	}
+	type Record struct {
+		ID        int
+		CreatedAt time.Time
+		Items     []Item
+	}
+
+	expected := Record{ID: 1, Items: []Item{{Name: "a"}}}
+	actual := Record{ID: 7, CreatedAt: time.Now(), Items: []Item{{Name: "a", UpdatedAt: time.Now()}}}
+
+	t.Run("passes when only ignored fields differ", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, actual, expected, WithIgnoreFields("ID", "CreatedAt"), WithIgnoreFields("Items[*].UpdatedAt"))
+		})
+		if failed {
+			t.Errorf("Expected BeEqual to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("reports fields that are not ignored", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, actual, expected, WithIgnoreFields("CreatedAt", "Items"))
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		if !strings.Contains(message, "└─ ID: 1 ≠ 7") {
+			t.Errorf("Expected ID difference, got:\n%s", message)
+		}
+		if strings.Contains(message, "└─ CreatedAt") || strings.Contains(message, "└─ Items") {
+			t.Errorf("Expected ignored fields to be left out of the report, got:\n%s", message)
+		}
+	})
+
+	t.Run("still compares fields without exported state", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, actual, expected, WithIgnoreFields("ID", "Items"))
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		if !strings.Contains(message, "└─ CreatedAt: 0001-01-01 00:00:00 +0000 UTC ≠ ") {
+			t.Errorf("Expected CreatedAt difference, got:\n%s", message)
+		}
+	})
+
+	t.Run("NotBeEqual fails when only ignored fields differ", func(t *testing.T) {
+		t.Parallel()
+		failed, _ := assertFails(t, func(t testing.TB) {
+			NotBeEqual(t, actual, expected, WithIgnoreFields("ID", "CreatedAt", "Items[*].UpdatedAt"))
+		})
+		if !failed {
+			t.Error("Expected NotBeEqual to fail")
+		}
+	})
+}
+
+// === Tests for WithUnexportedFields ===
+
+func TestBeEqual_UnexportedFields(t *testing.T) {
+	t.Parallel()
+
+	type counter struct {
+		Name  string
+		count int
+	}
+
+	t.Run("explains when only unexported fields differ", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, counter{Name: "hits", count: 2}, counter{Name: "hits", count: 1})
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		if !strings.Contains(message, "only unexported fields differ: count (use the WithUnexportedFields() option to compare them)") {
+			t.Errorf("Expected an explanation, got:\n%s", message)
+		}
+	})
+
+	t.Run("reports unexported field values when enabled", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, counter{Name: "hits", count: 2}, counter{Name: "hits", count: 1}, WithUnexportedFields())
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		if !strings.Contains(message, "└─ count: 1 ≠ 2") {
+			t.Errorf("Expected count difference, got:\n%s", message)
+		}
+	})
+}
+
+// === Tests for Equal methods and WithComparer ===
+
+type money struct {
+	cents    int64
+	currency string
+}
+
+func (m money) Equal(other money) bool {
+	return m.cents == other.cents && strings.EqualFold(m.currency, other.currency)
+}
+
+func TestBeEqual_UsesEqualMethods(t *testing.T) {
+	t.Parallel()
+
+	type Invoice struct {
+		Total    money
+		IssuedAt time.Time
+	}
+
+	instant := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	sameInstant := instant.In(time.FixedZone("UTC+2", 2*3600))
+
+	t.Run("passes when Equal methods report equality", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t,
+				Invoice{Total: money{cents: 100, currency: "eur"}, IssuedAt: sameInstant},
+				Invoice{Total: money{cents: 100, currency: "EUR"}, IssuedAt: instant},
+			)
+		})
+		if failed {
+			t.Errorf("Expected BeEqual to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("ignores monotonic clock readings", func(t *testing.T) {
+		t.Parallel()
+		now := time.Now()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, now, now.Round(0))
+		})
+		if failed {
+			t.Errorf("Expected BeEqual to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("reports values whose Equal method fails", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, Invoice{IssuedAt: instant.Add(time.Hour)}, Invoice{IssuedAt: instant})
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		if !strings.Contains(message, "└─ IssuedAt: 2024-01-15 14:30:00 +0000 UTC ≠ 2024-01-15 15:30:00 +0000 UTC") {
+			t.Errorf("Expected IssuedAt difference, got:\n%s", message)
+		}
+	})
+
+	t.Run("NotBeEqual fails when Equal methods report equality", func(t *testing.T) {
+		t.Parallel()
+		failed, _ := assertFails(t, func(t testing.TB) {
+			NotBeEqual(t, sameInstant, instant)
+		})
+		if !failed {
+			t.Error("Expected NotBeEqual to fail")
+		}
+	})
+}
+
+func TestBeEqual_WithComparer(t *testing.T) {
+	t.Parallel()
+
+	type Reading struct {
+		Sensor string
+		Value  float64
+	}
+	approx := WithComparer(func(a, b float64) bool {
+		return math.Abs(a-b) < 0.01
+	})
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		BeEqual(t, []Reading{{"a", 1.001}, {"b", 2}}, []Reading{{"a", 1}, {"b", 2.004}}, approx)
+	})
+	if failed {
+		t.Errorf("Expected BeEqual to pass, but it failed with message: %q", message)
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		BeEqual(t, Reading{"a", 1.5}, Reading{"a", 1}, approx)
+	})
+	if !failed {
+		t.Fatal("Expected BeEqual to fail")
+	}
+	if !strings.Contains(message, "└─ Value: 1 ≠ 1.5") {
+		t.Errorf("Expected Value difference, got:\n%s", message)
+	}
+}
+
+// === Tests for WithIgnoreOrder ===
+
+func TestBeEqual_WithIgnoreOrder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes when only the order differs", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, []int{3, 1, 2}, []int{1, 2, 3}, WithIgnoreOrder())
+		})
+		if failed {
+			t.Errorf("Expected BeEqual to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("reports missing and unexpected elements", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, []string{"c", "a", "d"}, []string{"a", "b", "c"}, WithIgnoreOrder())
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		expectedParts := []string{
+			`└─ [1]: missing element "b"`,
+			`└─ [2]: unexpected element "d"`,
+		}
+		for _, part := range expectedParts {
+			if !strings.Contains(message, part) {
+				t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+			}
+		}
+	})
+}
+
+// === Tests for BeEqual line diffs ===
+
+func TestBeEqual_ShowsLineDiffForMultilineStrings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("multi-line strings", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, "Hello Ann,\nyour order has shipped.\nThanks", "Hello Ann,\nyour order is ready.\nThanks",
+				WithMessage("email body"))
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		expected := "email body\nNot equal:\n--- expected\n+++ actual\n@@ -1,3 +1,3 @@\n" +
+			" Hello Ann,\n-your order is ready.\n+your order has shipped.\n Thanks"
+		if message != expected {
+			t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+		}
+	})
+
+	t.Run("long single-line strings", func(t *testing.T) {
+		t.Parallel()
+		long := strings.Repeat("x", 300)
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, long+"%d", long)
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		if !strings.Contains(message, "@@ -1,1 +1,1 @@\n-"+long+"\n+"+long+"%d") {
+			t.Errorf("Expected a line diff, got:\n%s", message)
+		}
+	})
+}
+
+func TestBeEqual_ShowsCharacterDiffForStrings(t *testing.T) {
+	t.Parallel()
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		BeEqual(t, "100% done", "100% Done")
+	})
+	if !failed {
+		t.Fatal("Expected BeEqual to fail")
+	}
+	expected := "Not equal:\nexpected: 100% Done\nactual  : 100% done\n" +
+		"               ^\nFirst difference at position 6 (case difference)"
+	if message != expected {
+		t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+	}
+}
+
+// === Tests for look-alike characters in string failures ===
+
+func TestStringFailures_RevealLookAlikeCharacters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		assert func(t testing.TB)
+		want   []string
+	}{
+		{
+			name:   "BeEqual with a non-breaking space",
+			assert: func(t testing.TB) { BeEqual(t, "hello\u00a0world", "hello world") },
+			want: []string{
+				"First difference at position 6\n",
+				"  expected: hello␠world",
+				"  actual  : hello<U+00A0>world",
+				"    └─ position 6: U+00A0 NO-BREAK SPACE (Zs, space separator)",
+			},
+		},
+		{
+			name:   "BeEqual with CRLF line endings",
+			assert: func(t testing.TB) { BeEqual(t, "a\r\nb", "a\nb") },
+			want: []string{
+				"  actual  : a\\r\\nb",
+				"    └─ position 2: U+000D CARRIAGE RETURN (Cc, control)",
+			},
+		},
+		{
+			name:   "StartWith with a zero width space",
+			assert: func(t testing.TB) { StartWith(t, "\u200bhello world", "hello") },
+			want: []string{
+				"  actual: <U+200B>hello␠world",
+				"    └─ position 1: U+200B ZERO WIDTH SPACE (Cf, format)",
+			},
+		},
+		{
+			name:   "ContainSubstring with a Cyrillic letter",
+			assert: func(t testing.TB) { ContainSubstring(t, "login to paypal", "раypal") },
+			want: []string{
+				"  substring: <U+0440><U+0430>ypal",
+				"    └─ position 1: U+0440 Cyrillic letter 'р', looks like Latin 'p' (Ll, lowercase letter)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			failed, message := assertFails(t, tt.assert)
+			if !failed {
+				t.Fatal("Expected the assertion to fail")
+			}
+			for _, part := range tt.want {
+				if !strings.Contains(message, part) {
+					t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+				}
+			}
+		})
+	}
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		BeEqual(t, "apple", "orange")
+	})
+	if !failed || strings.Contains(message, "look alike") {
+		t.Errorf("Expected no look-alike note for different strings, got:\n%s", message)
+	}
+}
+
+// === Tests for BeEqual hexdumps ===
+
+func TestBeEqual_ShowsHexDumpForBytes(t *testing.T) {
+	t.Parallel()
+
+	type Frame struct {
+		ID      int
+		Payload []byte
+	}
+
+	tests := []struct {
+		name     string
+		actual   any
+		expected any
+		want     []string
+	}{
+		{
+			name:     "byte slices",
+			actual:   []byte{0xca, 0xfe, 0x00},
+			expected: []byte{0xca, 0xfe},
+			want: []string{
+				"Bytes differ at offset 2 (expected 2 bytes, actual 3 bytes):",
+				"≠ 00000000  ca fe                    ..        ca fe 00                 ...",
+			},
+		},
+		{
+			name:     "byte arrays",
+			actual:   [4]byte{1, 2, 3, 4},
+			expected: [4]byte{1, 2, 3, 5},
+			want:     []string{"≠ 00000000  01 02 03 05              ....      01 02 03 04              ...."},
+		},
+		{
+			name:     "json.RawMessage",
+			actual:   json.RawMessage(`{"a":1}`),
+			expected: json.RawMessage(`{"a":2}`),
+			want:     []string{`{"a":2}   7b 22 61 22 3a 31 7d     {"a":1}`},
+		},
+		{
+			name:     "nested byte slices",
+			actual:   Frame{ID: 1, Payload: []byte("abc")},
+			expected: Frame{ID: 1, Payload: []byte("abd")},
+			want: []string{
+				"└─ Payload: bytes differ at offset 2 (expected 3 bytes, actual 3 bytes)",
+				"Payload: Bytes differ at offset 2",
+				"≠ 00000000  61 62 64                 abd       61 62 63                 abc",
+			},
+		},
+		{
+			name:     "nil and empty byte slices",
+			actual:   []byte{},
+			expected: []byte(nil),
+			want:     []string{"Not equal:\nexpected: nil slice\nactual  : empty slice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			failed, message := assertFails(t, func(t testing.TB) {
+				BeEqual(t, tt.actual, tt.expected)
+			})
+			if !failed {
+				t.Fatal("Expected BeEqual to fail")
+			}
+			for _, part := range tt.want {
+				if !strings.Contains(message, part) {
+					t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+				}
+			}
+		})
+	}
+}
+
+func TestBeEqual_WithInterfaceAndFuncFields(t *testing.T) {
+	t.Parallel()
+
+	type Job struct {
+		Input any
+		Run   func(string) string
+	}
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		BeEqual(t, Job{Input: "42", Run: strings.ToLower}, Job{Input: 42})
+	})
+	if !failed {
+		t.Fatal("Expected BeEqual to fail")
+	}
+	for _, part := range []string{
+		"expected: Job{Input: 42, Run: nil}",
+		`actual  : Job{Input: "42", Run: func strings.ToLower}`,
+		`└─ Input: type int ≠ string (42 ≠ "42")`,
+		"└─ Run: nil ≠ func strings.ToLower",
+	} {
+		if !strings.Contains(message, part) {
+			t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+		}
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		BeEqual(t, nil, &Job{})
+	})
+	if !failed || !strings.Contains(message, "└─ : &Job{Input: nil, Run: nil} ≠ nil") {
+		t.Errorf("Expected BeEqual to fail for a nil actual value, got: %q", message)
+	}
+}
+
+func TestBeEqual_ShowsErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	type Result struct {
+		Code int
+		Err  error
+	}
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		BeEqual(t, Result{Code: 1, Err: errors.New("y")}, Result{Code: 1, Err: errors.New("x")})
+	})
+	if !failed {
+		t.Fatal("Expected BeEqual to fail")
+	}
+	for _, part := range []string{"expected: Result{Code: 1, Err: x}", "actual  : Result{Code: 1, Err: y}"} {
+		if !strings.Contains(message, part) {
+			t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+		}
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		Contain(t, []error{errors.New("a")}, errors.New("b"))
+	})
+	if !failed || !strings.Contains(message, "Collection: [a]\n  Missing   : b") {
+		t.Errorf("Expected Contain to show error messages, got:\n%s", message)
+	}
+}
+
+func TestBeEqual_WithCyclicValues(t *testing.T) {
+	t.Parallel()
+
+	type Node struct {
+		Name     string
+		Parent   *Node
+		Children []*Node
+	}
+	tree := func(child string) *Node {
+		root := &Node{Name: "root"}
+		root.Children = []*Node{{Name: child, Parent: root}}
+		return root
+	}
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		BeEqual(t, tree("b"), tree("a"))
+	})
+	if !failed {
+		t.Fatal("Expected BeEqual to fail")
+	}
+	expected := `Differences found:
+Not equal:
+expected: &Node{
+  Name: "root",
+  Parent: nil,
+  Children: [&Node{Name: "a", Parent: <cycle to root>, Children: nil}],
+}
+actual  : &Node{
+  Name: "root",
+  Parent: nil,
+  Children: [&Node{Name: "b", Parent: <cycle to root>, Children: nil}],
+}
+Field differences:
+  └─ Children.[0].Name: "a" ≠ "b"
+`
+	if message != expected {
+		t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+	}
+}
+
+func TestBeEqual_WithGoLiteral(t *testing.T) {
+	t.Parallel()
+
+	type Item struct {
+		Name string
+		Qty  int
+	}
+
+	t.Run("shows the actual value as a Go literal", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, []Item{{Name: "a", Qty: 2}}, []Item{{Name: "a", Qty: 1}}, WithGoLiteral())
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		expected := `Differences found:
+Not equal:
+expected: [Item{Name: "a", Qty: 1}]
+actual  : [Item{Name: "a", Qty: 2}]
+Field differences:
+  └─ [0].Qty: 1 ≠ 2
+
+Actual as Go literal:
+[]Item{
+	{
+		Name: "a",
+		Qty:  2,
+	},
+}
+`
+		if message != expected {
+			t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+		}
+	})
+
+	t.Run("works for primitive values", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, int64(3), int64(4), WithGoLiteral())
+		})
+		if !failed || !strings.HasSuffix(message, "\n\nActual as Go literal:\nint64(3)") {
+			t.Errorf("Expected the actual value as a Go literal, got: %q", message)
+		}
+	})
+
+	t.Run("leaves out the literal for cyclic values", func(t *testing.T) {
+		t.Parallel()
+		type node struct {
+			Name string
+			Next *node
+		}
+		loop := &node{Name: "a"}
+		loop.Next = loop
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, loop, &node{Name: "b"}, WithGoLiteral())
+		})
+		want := "\n\nActual as Go literal: not available, it has a cycle: Next refers back to root\n"
+		if !failed || !strings.HasSuffix(message, want) {
+			t.Errorf("Expected the Go literal to be left out, got: %q", message)
+		}
+	})
+
+	t.Run("is off by default", func(t *testing.T) {
+		t.Parallel()
+		_, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, 3, 4)
+		})
+		if strings.Contains(message, "Go literal") {
+			t.Errorf("Unexpected Go literal in message: %q", message)
+		}
+	})
+}
+
+func TestStringAssertions_WithScrubbers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("BeEqual compares scrubbed strings", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, "order 3f2504e0-4f89-11d3-9a0c-0305e82c3301 paid", "order <UUID> paid", WithScrubUUIDs())
+		})
+		if failed {
+			t.Errorf("Expected BeEqual to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("BeEqual shows the scrubbed strings", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			BeEqual(t, "created 2024-05-01T10:20:30Z by bob", "created <TIMESTAMP> by ann", WithScrubTimestamps())
+		})
+		if !failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		if !strings.Contains(message, "actual  : created <TIMESTAMP> by bob") || strings.Contains(message, "2024") {
+			t.Errorf("Expected the message to show the scrubbed string, got:\n%s", message)
+		}
+	})
+
+	t.Run("ContainSubstring searches the scrubbed string", func(t *testing.T) {
+		t.Parallel()
+		port := WithScrubber(regexp.MustCompile(`:\d+\b`), ":<PORT>")
+		failed, message := assertFails(t, func(t testing.TB) {
+			ContainSubstring(t, "listening on 127.0.0.1:54321", "127.0.0.1:<PORT>", port)
+		})
+		if failed {
+			t.Errorf("Expected ContainSubstring to pass, but it failed with message: %q", message)
+		}
+	})
+}
@@ -3,8 +3,11 @@ package assert
 import (
 	"fmt"
 	"math"
+	"net"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 	"unsafe"
 )
 
@@ -513,8 +516,9 @@ func TestFindDifferences_SlicesAndArrays(t *testing.T) {
 			actual:   []int{1, 2},
 			want: []fieldDiff{
 				{
-					Path:    "",
-					Message: "length mismatch (expected: 3, actual: 2)",
+					Path:     "",
+					Expected: 3,
+					Message:  "- [2] 3",
 				},
 			},
 		},
@@ -997,6 +1001,671 @@ func TestCompareExpectedActual_ConsistentResultsDespiteMapKeyOrder(t *testing.T)
 	}
 }
 
+func TestFindDifferences_IgnoreFields(t *testing.T) {
+	t.Parallel()
+
+	type Meta struct {
+		CreatedAt string
+		Version   int
+	}
+	type Item struct {
+		Name      string
+		UpdatedAt string
+	}
+	type Order struct {
+		ID    int
+		Meta  Meta
+		Items []Item
+		Tags  map[string]string
+	}
+
+	expected := Order{
+		ID:    1,
+		Meta:  Meta{CreatedAt: "monday", Version: 1},
+		Items: []Item{{Name: "book", UpdatedAt: "monday"}, {Name: "pen", UpdatedAt: "monday"}},
+		Tags:  map[string]string{"env": "prod", "trace": "a1"},
+	}
+	actual := Order{
+		ID:    2,
+		Meta:  Meta{CreatedAt: "tuesday", Version: 2},
+		Items: []Item{{Name: "book", UpdatedAt: "tuesday"}, {Name: "pen", UpdatedAt: "friday"}},
+		Tags:  map[string]string{"env": "dev", "trace": "b2"},
+	}
+
+	tests := []struct {
+		name   string
+		ignore []string
+		want   []fieldDiff
+	}{
+		{
+			name:   "no ignored fields",
+			ignore: nil,
+			want: []fieldDiff{
+				{Path: "ID", Expected: 1, Actual: 2},
+				{Path: "Meta.CreatedAt", Expected: "monday", Actual: "tuesday"},
+				{Path: "Meta.Version", Expected: 1, Actual: 2},
+				{Path: "Items.[0].UpdatedAt", Expected: "monday", Actual: "tuesday"},
+				{Path: "Items.[1].UpdatedAt", Expected: "monday", Actual: "friday"},
+				{Path: "Tags.[env]", Expected: "prod", Actual: "dev"},
+				{Path: "Tags.[trace]", Expected: "a1", Actual: "b2"},
+			},
+		},
+		{
+			name:   "top-level, nested and wildcard paths",
+			ignore: []string{"ID", "Meta.CreatedAt", "Items[*].UpdatedAt", "Tags[trace]"},
+			want: []fieldDiff{
+				{Path: "Meta.Version", Expected: 1, Actual: 2},
+				{Path: "Tags.[env]", Expected: "prod", Actual: "dev"},
+			},
+		},
+		{
+			name:   "ignoring a parent ignores its children",
+			ignore: []string{"ID", "Meta", "Items", "Tags"},
+			want:   []fieldDiff{},
+		},
+		{
+			name:   "specific index",
+			ignore: []string{"ID", "Meta", "Tags", "Items.[1].UpdatedAt"},
+			want: []fieldDiff{
+				{Path: "Items.[0].UpdatedAt", Expected: "monday", Actual: "tuesday"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := findDifferencesWithConfig(expected, actual, &Config{IgnoreFields: tt.ignore})
+			if !diffsAreEqual(got, tt.want) {
+				t.Errorf("findDifferencesWithConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindDifferences_UnexportedFields(t *testing.T) {
+	t.Parallel()
+
+	type inner struct {
+		secret string
+	}
+	type account struct {
+		Name    string
+		balance int
+		details *inner
+	}
+
+	expected := account{Name: "ann", balance: 10, details: &inner{secret: "a"}}
+	actual := account{Name: "ann", balance: 20, details: &inner{secret: "b"}}
+
+	t.Run("names the differing fields by default", func(t *testing.T) {
+		t.Parallel()
+		got := findDifferencesWithConfig(expected, actual, &Config{})
+		if len(got) != 1 {
+			t.Fatalf("Expected a single difference, got %v", got)
+		}
+		want := "only unexported fields differ: balance, details (use the WithUnexportedFields() option to compare them)"
+		if got[0].Path != "" || got[0].Message != want {
+			t.Errorf("Unexpected difference: path %q, message %q", got[0].Path, got[0].Message)
+		}
+	})
+
+	t.Run("compares unexported fields when enabled", func(t *testing.T) {
+		t.Parallel()
+		got := findDifferencesWithConfig(expected, actual, &Config{UnexportedFields: true})
+		want := []fieldDiff{
+			{Path: "balance", Expected: 10, Actual: 20},
+			{Path: "details.secret", Expected: "a", Actual: "b"},
+		}
+		if !diffsAreEqual(got, want) {
+			t.Errorf("findDifferencesWithConfig() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("exported differences take precedence", func(t *testing.T) {
+		t.Parallel()
+		renamed := actual
+		renamed.Name = "bob"
+		got := findDifferencesWithConfig(expected, renamed, &Config{})
+		want := []fieldDiff{{Path: "Name", Expected: "ann", Actual: "bob"}}
+		if !diffsAreEqual(got, want) {
+			t.Errorf("findDifferencesWithConfig() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("ignored unexported fields are not reported", func(t *testing.T) {
+		t.Parallel()
+		got := findDifferencesWithConfig(expected, actual, &Config{IgnoreFields: []string{"balance", "details"}})
+		if len(got) != 0 {
+			t.Errorf("Expected no differences, got %v", got)
+		}
+	})
+}
+
+func TestFindDifferences_CustomEquality(t *testing.T) {
+	t.Parallel()
+
+	type Celsius float64
+	type Event struct {
+		At   time.Time
+		Temp Celsius
+		IP   net.IP
+	}
+
+	instant := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	expected := Event{At: instant, Temp: 20, IP: net.IPv4(10, 0, 0, 1)}
+	actual := Event{At: instant.Local(), Temp: 20.4, IP: net.ParseIP("10.0.0.1").To4()}
+
+	tests := []struct {
+		name string
+		cfg  *Config
+		want []fieldDiff
+	}{
+		{
+			name: "Equal methods",
+			cfg:  &Config{},
+			want: []fieldDiff{{Path: "Temp", Expected: Celsius(20), Actual: Celsius(20.4)}},
+		},
+		{
+			name: "registered comparer",
+			cfg: processOptions(WithComparer(func(a, b Celsius) bool {
+				return math.Round(float64(a)) == math.Round(float64(b))
+			})),
+			want: []fieldDiff{},
+		},
+		{
+			name: "comparer takes precedence over Equal",
+			cfg: processOptions(WithComparer(func(a, b time.Time) bool {
+				return a.Location() == b.Location()
+			}), WithIgnoreFields("Temp")),
+			want: []fieldDiff{{Path: "At", Expected: instant, Actual: instant.Local()}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := findDifferencesWithConfig(expected, actual, tt.cfg)
+			if !diffsAreEqual(got, tt.want) {
+				t.Errorf("findDifferencesWithConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindDifferences_ReportsTypeChanges(t *testing.T) {
+	t.Parallel()
+
+	type ID int
+	got := findDifferences([]any{ID(1), [2]int{1, 2}}, []any{1, [2]int{1, 3}})
+	want := []fieldDiff{
+		{Path: "[0]", Expected: reflect.TypeOf(ID(0)), Actual: reflect.TypeOf(0)},
+		{Path: "[1].[1]", Expected: 2, Actual: 3},
+	}
+	if !diffsAreEqual(got, want) {
+		t.Errorf("findDifferences() = %v, want %v", got, want)
+	}
+}
+
+func TestFindDifferences_IgnoreOrder(t *testing.T) {
+	t.Parallel()
+
+	type Line struct {
+		SKU  string
+		Tags []string
+	}
+	type Order struct {
+		Lines []Line
+		Notes []string
+	}
+
+	expected := Order{
+		Lines: []Line{{SKU: "a", Tags: []string{"x", "y"}}, {SKU: "b"}},
+		Notes: []string{"first", "second"},
+	}
+	reordered := Order{
+		Lines: []Line{{SKU: "b"}, {SKU: "a", Tags: []string{"y", "x"}}},
+		Notes: []string{"second", "first"},
+	}
+
+	tests := []struct {
+		name   string
+		actual Order
+		opts   []Option
+		want   []fieldDiff
+	}{
+		{
+			name:   "every slice at any depth",
+			actual: reordered,
+			opts:   []Option{WithIgnoreOrder()},
+			want:   []fieldDiff{},
+		},
+		{
+			name:   "only the given paths",
+			actual: reordered,
+			opts:   []Option{WithIgnoreOrder("Lines", "Lines[*].Tags")},
+			want: []fieldDiff{
+				{Path: "Notes", Expected: "first"},
+				{Path: "Notes", Actual: "first"},
+			},
+		},
+		{
+			name: "missing and unexpected elements",
+			actual: Order{
+				Lines: []Line{{SKU: "b"}, {SKU: "c"}, {SKU: "a", Tags: []string{"x", "y"}}},
+				Notes: []string{"second", "second"},
+			},
+			opts: []Option{WithIgnoreOrder()},
+			want: []fieldDiff{
+				{Path: "Lines.[1]", Actual: Line{SKU: "c"}},
+				{Path: "Notes.[0]", Expected: "first"},
+				{Path: "Notes.[1]", Actual: "second"},
+			},
+		},
+		{
+			name:   "duplicates are counted",
+			actual: Order{Lines: expected.Lines, Notes: []string{"first", "second", "first"}},
+			opts:   []Option{WithIgnoreOrder("Notes")},
+			want:   []fieldDiff{{Path: "Notes.[2]", Actual: "first"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := findDifferencesWithConfig(expected, tt.actual, processOptions(tt.opts...))
+			if !diffsAreEqual(got, tt.want) {
+				t.Errorf("findDifferencesWithConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindDifferences_SequenceDiff(t *testing.T) {
+	t.Parallel()
+
+	type Item struct {
+		Name string
+		Qty  int
+	}
+
+	tests := []struct {
+		name     string
+		expected any
+		actual   any
+		want     []fieldDiff
+		messages []string
+	}{
+		{
+			name:     "inserted element does not shift the rest",
+			expected: []Item{{"a", 1}, {"b", 1}, {"c", 1}, {"d", 1}},
+			actual:   []Item{{"a", 1}, {"b", 1}, {"c", 1}, {"x", 9}, {"d", 1}},
+			want:     []fieldDiff{{Path: "", Actual: Item{"x", 9}}},
+			messages: []string{`+ [3] Item{Name: "x", Qty: 9}`},
+		},
+		{
+			name:     "removed element",
+			expected: []string{"a", "b", "c"},
+			actual:   []string{"a", "c"},
+			want:     []fieldDiff{{Path: "", Expected: "b"}},
+			messages: []string{`- [1] "b"`},
+		},
+		{
+			name:     "changed element is compared field by field",
+			expected: []Item{{"a", 1}, {"b", 1}, {"c", 1}},
+			actual:   []Item{{"x", 0}, {"a", 1}, {"b", 2}, {"c", 1}},
+			want: []fieldDiff{
+				{Path: "", Actual: Item{"x", 0}},
+				{Path: "[1].Qty", Expected: 1, Actual: 2},
+			},
+			messages: []string{`+ [0] Item{Name: "x", Qty: 0}`, ""},
+		},
+		{
+			name:     "removals and insertions between the same elements",
+			expected: [5]int{1, 2, 3, 4, 5},
+			actual:   [5]int{1, 7, 3, 5, 6},
+			want: []fieldDiff{
+				{Path: "[1]", Expected: 2, Actual: 7},
+				{Path: "", Expected: 4},
+				{Path: "", Actual: 6},
+			},
+			messages: []string{"", "- [3] 4", "+ [4] 6"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := findDifferences(tt.expected, tt.actual)
+			if !diffsAreEqual(got, tt.want) {
+				t.Fatalf("findDifferences() = %v, want %v", got, tt.want)
+			}
+			for i, diff := range got {
+				if diff.Message != tt.messages[i] {
+					t.Errorf("diff %d message = %q, want %q", i, diff.Message, tt.messages[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFindDifferences_ByteSequences(t *testing.T) {
+	t.Parallel()
+
+	type Packet struct {
+		Header [2]byte
+		Body   []byte
+	}
+
+	expected := Packet{Header: [2]byte{1, 2}, Body: []byte{1, 2, 3}}
+	actual := Packet{Header: [2]byte{1, 2}, Body: []byte{1, 2, 3, 4}}
+
+	got := findDifferences(expected, actual)
+	if len(got) != 1 {
+		t.Fatalf("Expected a single difference, got %v", got)
+	}
+	if got[0].Path != "Body" || got[0].Message != "bytes differ at offset 3 (expected 3 bytes, actual 4 bytes)" {
+		t.Errorf("Unexpected difference: path %q, message %q", got[0].Path, got[0].Message)
+	}
+}
+
+func TestFindDifferences_MapsInKeyOrder(t *testing.T) {
+	t.Parallel()
+
+	expected := map[int]string{1: "a", 2: "b", 3: "c", 10: "j", 20: "t"}
+	actual := map[int]string{1: "A", 2: "b", 3: "C", 11: "k", 20: "T"}
+	want := "[1] [3] [10] [20] [11]"
+
+	for run := 0; run < 10; run++ {
+		var paths []string
+		for _, diff := range findDifferences(expected, actual) {
+			paths = append(paths, diff.Path)
+		}
+		if got := strings.Join(paths, " "); got != want {
+			t.Fatalf("findDifferences() paths = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestFindDifferences_InterfacesFuncsAndChannels(t *testing.T) {
+	t.Parallel()
+
+	type Event struct {
+		Payload any
+		Point   complex128
+		Handler func(string) string
+		Done    chan struct{}
+		Raw     unsafe.Pointer
+	}
+	done := make(chan struct{})
+	x, y := 1, 2
+
+	tests := []struct {
+		name     string
+		expected any
+		actual   any
+		messages map[string]string
+	}{
+		{
+			name:     "equal values",
+			expected: Event{Payload: 1, Point: 1 + 2i, Done: done, Raw: unsafe.Pointer(&x)},
+			actual:   Event{Payload: 1, Point: 1 + 2i, Done: done, Raw: unsafe.Pointer(&x)},
+		},
+		{
+			name:     "interface fields holding another value",
+			expected: Event{Payload: []any{1, "a"}},
+			actual:   Event{Payload: []any{1, "b"}},
+			messages: map[string]string{"Payload.[1]": ""},
+		},
+		{
+			name:     "interface fields holding another concrete type",
+			expected: Event{Payload: map[string]any{"id": 1, "tags": []string{"a"}}},
+			actual:   Event{Payload: map[string]any{"id": "1", "tags": []string{"a"}}},
+			messages: map[string]string{"Payload.[id]": `type int ≠ string (1 ≠ "1")`},
+		},
+		{
+			name:     "complex numbers",
+			expected: Event{Point: 1 + 2i},
+			actual:   Event{Point: 1 - 2i},
+			messages: map[string]string{"Point": ""},
+		},
+		{
+			name:     "nil and non-nil funcs",
+			expected: Event{Handler: strings.ToUpper},
+			actual:   Event{},
+			messages: map[string]string{"Handler": "func strings.ToUpper ≠ nil"},
+		},
+		{
+			name:     "different funcs",
+			expected: Event{Handler: strings.ToUpper},
+			actual:   Event{Handler: strings.ToLower},
+			messages: map[string]string{
+				"Handler": "func strings.ToUpper ≠ func strings.ToLower (funcs are only equal when both are nil)",
+			},
+		},
+		{
+			name:     "same func",
+			expected: Event{Handler: strings.ToUpper},
+			actual:   Event{Handler: strings.ToUpper},
+			messages: map[string]string{
+				"Handler": "func strings.ToUpper ≠ func strings.ToUpper (funcs are only equal when both are nil)",
+			},
+		},
+		{
+			name:     "nil and non-nil channels",
+			expected: Event{},
+			actual:   Event{Done: done},
+			messages: map[string]string{"Done": fmt.Sprintf("nil ≠ chan struct {} %p", done)},
+		},
+		{
+			name:     "different channels",
+			expected: Event{Done: done},
+			actual:   Event{Done: make(chan struct{})},
+			messages: map[string]string{"Done": ""},
+		},
+		{
+			name:     "unsafe pointers",
+			expected: Event{Raw: unsafe.Pointer(&x)},
+			actual:   Event{Raw: unsafe.Pointer(&y)},
+			messages: map[string]string{"Raw": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := findDifferences(tt.expected, tt.actual)
+			if len(got) != len(tt.messages) {
+				t.Fatalf("findDifferences() = %v, want differences at %v", got, tt.messages)
+			}
+			for _, diff := range got {
+				message, ok := tt.messages[diff.Path]
+				if !ok {
+					t.Errorf("Unexpected difference at %q: %v", diff.Path, diff)
+				} else if message != "" && diff.Message != message {
+					t.Errorf("Difference at %q has message %q, want %q", diff.Path, diff.Message, message)
+				}
+			}
+		})
+	}
+
+	t.Run("different channels mention their identity", func(t *testing.T) {
+		t.Parallel()
+		got := findDifferences(Event{Done: done}, Event{Done: make(chan struct{})})
+		if !strings.HasSuffix(got[0].Message, "(channels are only equal when they are the same channel)") {
+			t.Errorf("Unexpected message: %q", got[0].Message)
+		}
+	})
+}
+
+func TestFindDifferences_Cycles(t *testing.T) {
+	t.Parallel()
+
+	type ListNode struct {
+		Value      int
+		Prev, Next *ListNode
+	}
+	list := func(values ...int) *ListNode {
+		var head, tail *ListNode
+		for _, v := range values {
+			node := &ListNode{Value: v, Prev: tail}
+			if tail == nil {
+				head = node
+			} else {
+				tail.Next = node
+			}
+			tail = node
+		}
+		return head
+	}
+
+	type TreeNode struct {
+		Name     string
+		Parent   *TreeNode
+		Children []*TreeNode
+	}
+	tree := func(root string, children ...string) *TreeNode {
+		node := &TreeNode{Name: root}
+		for _, name := range children {
+			node.Children = append(node.Children, &TreeNode{Name: name, Parent: node})
+		}
+		return node
+	}
+
+	type GraphNode struct {
+		ID    string
+		Edges map[string]*GraphNode
+	}
+	graph := func(label string) *GraphNode {
+		a, b := &GraphNode{ID: "a"}, &GraphNode{ID: label}
+		a.Edges = map[string]*GraphNode{"self": a, "next": b}
+		b.Edges = map[string]*GraphNode{"back": a}
+		return a
+	}
+
+	tests := []struct {
+		name     string
+		expected any
+		actual   any
+		paths    []string
+	}{
+		{name: "equal doubly linked lists", expected: list(1, 2, 3), actual: list(1, 2, 3)},
+		{name: "doubly linked lists", expected: list(1, 2, 3), actual: list(1, 2, 4), paths: []string{"Next.Next.Value"}},
+		{name: "trees with back-pointers", expected: tree("root", "a", "b"), actual: tree("root", "a", "c"),
+			paths: []string{"Children.[1].Name"}},
+		{name: "graph nodes", expected: graph("b"), actual: graph("c"), paths: []string{"Edges.[next].ID"}},
+		{name: "cycles of different lengths", expected: func() *ListNode {
+			node := &ListNode{Value: 1}
+			node.Next = node
+			return node
+		}(), actual: func() *ListNode {
+			first, second := &ListNode{Value: 1}, &ListNode{Value: 1}
+			first.Next, second.Next = second, first
+			return first
+		}()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, diff := range findDifferences(tt.expected, tt.actual) {
+				got = append(got, diff.Path)
+			}
+			if strings.Join(got, ", ") != strings.Join(tt.paths, ", ") {
+				t.Errorf("findDifferences() paths = %v, want %v", got, tt.paths)
+			}
+		})
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "ID", path: "ID", want: true},
+		{pattern: "ID", path: "UserID", want: false},
+		{pattern: "Meta.CreatedAt", path: "Meta.CreatedAt", want: true},
+		{pattern: "Meta.CreatedAt", path: "Meta", want: false},
+		{pattern: "Items[*].UpdatedAt", path: "Items.[3].UpdatedAt", want: true},
+		{pattern: "Items.[*].UpdatedAt", path: "Items.[3].UpdatedAt", want: true},
+		{pattern: "Items[2].UpdatedAt", path: "Items.[3].UpdatedAt", want: false},
+		{pattern: "[*].ID", path: "[0].ID", want: true},
+		{pattern: "*.ID", path: "User.ID", want: true},
+		{pattern: "Items[*]", path: "Items.Name", want: false},
+		{pattern: "[config.v1].[debug]", path: "[config.v1].[debug]", want: true},
+		{pattern: "Labels[app.kubernetes.io/name]", path: "Labels.[app.kubernetes.io/name]", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			t.Parallel()
+			if got := matchPath(tt.pattern, tt.path); got != tt.want {
+				t.Errorf("matchPath(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelaxesEquality(t *testing.T) {
+	t.Parallel()
+
+	type event struct {
+		Name string
+		At   time.Time
+	}
+	type node struct {
+		Name string
+		Next *node
+	}
+	loop := &node{Name: "a"}
+	loop.Next = loop
+
+	tests := []struct {
+		name     string
+		expected any
+		actual   any
+		cfg      Config
+		want     bool
+	}{
+		{name: "plain values", expected: node{Name: "a"}, actual: node{Name: "b"}, want: false},
+		{name: "basic slices", expected: []int{1, 2}, actual: []int{1, 3}, want: false},
+		{name: "cycles", expected: loop, actual: &node{Name: "b"}, want: false},
+		{name: "ignored fields", expected: 1, actual: 2, cfg: Config{IgnoreFields: []string{"ID"}}, want: true},
+		{name: "ignored order", expected: []int{1, 2}, actual: []int{2, 1}, cfg: Config{IgnoreOrder: true}, want: true},
+		{name: "Equal method in a field", expected: event{Name: "a"}, actual: event{Name: "b"}, want: true},
+		{name: "Equal method in an interface", expected: []any{1}, actual: []any{time.Time{}}, want: true},
+		{name: "Equal method in a map value", expected: map[string]any{}, actual: map[string]any{"at": net.IP{}}, want: true},
+		{
+			name:     "comparer for a basic type",
+			expected: []float64{1},
+			actual:   []float64{1.0001},
+			cfg:      Config{Comparers: map[reflect.Type]func(a, b any) bool{reflect.TypeOf(0.0): nil}},
+			want:     true,
+		},
+		{
+			name:     "comparer for the element type",
+			expected: []node{{Name: "a"}},
+			actual:   []node{{Name: "b"}},
+			cfg:      Config{Comparers: map[reflect.Type]func(a, b any) bool{reflect.TypeOf(node{}): nil}},
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := relaxesEquality(&tt.cfg, tt.expected, tt.actual); got != tt.want {
+				t.Errorf("relaxesEquality() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // Helper function to compare fieldDiff slices without relying on order
 func diffsAreEqual(a, b []fieldDiff) bool {
 	if len(a) != len(b) {
@@ -0,0 +1,167 @@
+package assert
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// G is a recorder that collects assertion failures instead of reporting them
+// one by one. It implements testing.TB, so it can be passed to any assertion.
+//
+// A G is created by Group and must only be used while the group is running.
+type G struct {
+	testing.TB
+
+	mu          sync.Mutex
+	failures    []groupFailure
+	helpers     map[string]struct{}
+	maxFailures int
+	stopped     bool
+}
+
+// groupFailure is a single failure recorded by a G.
+type groupFailure struct {
+	Location string
+	Message  string
+}
+
+// groupStop is the panic value used to end a group early.
+type groupStop struct{}
+
+// Group runs fn with a recorder and reports every failure it collected as a single,
+// numbered summary that includes the source line of each failed check.
+//
+// By default all checks in fn are executed. Use WithMaxFailures to stop the group
+// once a given number of failures has been recorded.
+//
+// Example:
+//
+//	should.Group(t, func(g *should.G) {
+//		should.BeEqual(g, user.Name, "John")
+//		should.BeGreaterThan(g, user.Age, 18)
+//	}, should.WithMaxFailures(5))
+func Group(t testing.TB, fn func(g *G), opts ...Option) bool {
+	t.Helper()
+
+	cfg := processOptions(opts...)
+	g := newG(t, cfg.MaxFailures)
+	g.run(fn)
+
+	if len(g.failures) == 0 {
+		return true
+	}
+
+	failWithOptions(t, cfg, formatGroupSummary(g.failures, g.stopped, g.maxFailures))
+	return false
+}
+
+func newG(t testing.TB, maxFailures int) *G {
+	return &G{
+		TB:          t,
+		helpers:     make(map[string]struct{}),
+		maxFailures: maxFailures,
+	}
+}
+
+// run executes fn, treating a stop request as the end of the group.
+func (g *G) run(fn func(g *G)) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(groupStop); !ok {
+				panic(r)
+			}
+		}
+	}()
+	fn(g)
+}
+
+// Helper marks the calling function as a helper, so that it is skipped when
+// resolving the source line of a failure.
+func (g *G) Helper() {
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		return
+	}
+	name := runtime.FuncForPC(pc).Name()
+
+	g.mu.Lock()
+	g.helpers[name] = struct{}{}
+	g.mu.Unlock()
+}
+
+// Error records a failure.
+func (g *G) Error(args ...any) {
+	g.record(fmt.Sprint(args...))
+}
+
+// Errorf records a formatted failure.
+func (g *G) Errorf(format string, args ...any) {
+	g.record(fmt.Sprintf(format, args...))
+}
+
+// Fail records a failure without a message.
+func (g *G) Fail() {
+	g.record("")
+}
+
+// Fatal records a failure and stops the group.
+func (g *G) Fatal(args ...any) {
+	g.record(fmt.Sprint(args...))
+	g.FailNow()
+}
+
+// Fatalf records a formatted failure and stops the group.
+func (g *G) Fatalf(format string, args ...any) {
+	g.record(fmt.Sprintf(format, args...))
+	g.FailNow()
+}
+
+// FailNow stops the group. The failures recorded so far are still reported.
+func (g *G) FailNow() {
+	g.mu.Lock()
+	if len(g.failures) == 0 {
+		g.failures = append(g.failures, groupFailure{Location: g.location(1)})
+	}
+	g.stopped = true
+	g.mu.Unlock()
+	panic(groupStop{})
+}
+
+// Failed reports whether the group has recorded any failure.
+func (g *G) Failed() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.failures) > 0
+}
+
+func (g *G) record(message string) {
+	g.mu.Lock()
+	g.failures = append(g.failures, groupFailure{Location: g.location(2), Message: message})
+	stop := g.maxFailures > 0 && len(g.failures) >= g.maxFailures
+	g.stopped = g.stopped || stop
+	g.mu.Unlock()
+
+	if stop {
+		panic(groupStop{})
+	}
+}
+
+// location returns the file and line of the first caller that is not a helper,
+// skipping the given number of G methods above it. It must be called with g.mu held.
+func (g *G) location(skip int) string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if _, isHelper := g.helpers[frame.Function]; !isHelper {
+			return fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
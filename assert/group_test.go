@@ -0,0 +1,153 @@
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// === Tests for Group ===
+
+func TestGroup_PassesWhenAllChecksPass(t *testing.T) {
+	t.Parallel()
+
+	var passed bool
+	failed, message := assertFails(t, func(t testing.TB) {
+		passed = Group(t, func(g *G) {
+			BeTrue(g, true)
+			BeEqual(g, 1, 1)
+		})
+	})
+
+	if failed || !passed {
+		t.Errorf("Expected group to pass, but it failed with message: %q", message)
+	}
+}
+
+func TestGroup_ReportsAllFailuresOnce(t *testing.T) {
+	t.Parallel()
+
+	mock := &countingT{mockT: mockT{T: t}}
+	var lines [2]int
+	passed := Group(mock, func(g *G) {
+		_, _, lines[0], _ = runtime.Caller(0)
+		BeTrue(g, false)
+		BeEqual(g, 1, 1)
+		_, _, lines[1], _ = runtime.Caller(0)
+		BeGreaterThan(g, 1, 5, WithMessage("count too low"))
+	})
+
+	if passed {
+		t.Fatal("Expected group to fail")
+	}
+	if mock.calls != 1 {
+		t.Errorf("Expected a single summary report, got %d", mock.calls)
+	}
+
+	expectedParts := []string{
+		"Group recorded 2 failures:",
+		fmt.Sprintf("1) group_test.go:%d\n   Expected true, got false", lines[0]+1),
+		fmt.Sprintf("2) group_test.go:%d\n   count too low\n   Expected value to be greater than threshold:", lines[1]+1),
+	}
+	for _, part := range expectedParts {
+		if !strings.Contains(mock.message, part) {
+			t.Errorf("Expected summary to contain %q, got:\n%s", part, mock.message)
+		}
+	}
+}
+
+func TestGroup_StopsAfterMaxFailures(t *testing.T) {
+	t.Parallel()
+
+	executed := 0
+	failed, message := assertFails(t, func(t testing.TB) {
+		Group(t, func(g *G) {
+			for i := 0; i < 5; i++ {
+				executed++
+				BeTrue(g, false)
+			}
+		}, WithMaxFailures(2))
+	})
+
+	if !failed {
+		t.Fatal("Expected group to fail")
+	}
+	if executed != 2 {
+		t.Errorf("Expected group to stop after 2 checks, but %d ran", executed)
+	}
+	if !strings.Contains(message, "Group recorded 2 failures:") {
+		t.Errorf("Expected two recorded failures, got:\n%s", message)
+	}
+	if !strings.Contains(message, "Group stopped after reaching the limit of 2 failures") {
+		t.Errorf("Expected stop note, got:\n%s", message)
+	}
+}
+
+func TestGroup_FailNowStopsTheGroup(t *testing.T) {
+	t.Parallel()
+
+	reachedEnd := false
+	failed, message := assertFails(t, func(t testing.TB) {
+		Group(t, func(g *G) {
+			g.Fatalf("cannot continue: %s", "missing fixture")
+			reachedEnd = true
+		})
+	})
+
+	if !failed || reachedEnd {
+		t.Fatal("Expected Fatalf to stop the group")
+	}
+	if !strings.Contains(message, "   cannot continue: missing fixture") {
+		t.Errorf("Expected fatal message in summary, got:\n%s", message)
+	}
+	if !strings.Contains(message, "Group stopped early by a fatal failure") {
+		t.Errorf("Expected stop note, got:\n%s", message)
+	}
+}
+
+func TestGroup_DoesNotSwallowOtherPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Expected panic %q to propagate, got %v", "boom", r)
+		}
+	}()
+
+	Group(&mockT{T: t}, func(g *G) {
+		panic("boom")
+	})
+}
+
+func TestGroup_FailedReflectsRecordedFailures(t *testing.T) {
+	t.Parallel()
+
+	assertFails(t, func(t testing.TB) {
+		Group(t, func(g *G) {
+			if g.Failed() {
+				t.Error("Expected a fresh group not to be failed")
+			}
+			BeNil(g, 1)
+			if !g.Failed() {
+				t.Error("Expected the group to be failed after a failing check")
+			}
+		})
+	})
+}
+
+// countingT counts how many times a failure was reported.
+type countingT struct {
+	mockT
+	calls int
+}
+
+func (c *countingT) Errorf(format string, args ...interface{}) {
+	c.calls++
+	c.mockT.Errorf(format, args...)
+}
+
+func (c *countingT) Error(args ...interface{}) {
+	c.calls++
+	c.mockT.Error(args...)
+}
@@ -0,0 +1,353 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// maxJSONValueLen limits the length of a JSON value shown in a difference.
+const maxJSONValueLen = 60
+
+// maxJSONDocumentLen limits the length of a JSON document shown in a failure.
+const maxJSONDocumentLen = 200
+
+// jsonMissing stands for a value that is absent from one of the documents.
+type jsonMissing struct{}
+
+// jsonIdentifier matches object keys that can be written as ".key" in a JSON path.
+var jsonIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// parseJSON decodes a JSON document given as a string, as a byte slice such as
+// json.RawMessage, or as any other value, which is marshaled first. Numbers are kept
+// as json.Number so that they can be compared without losing precision.
+func parseJSON(value any) (any, error) {
+	var data []byte
+	switch v := value.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	case json.RawMessage:
+		data = v
+	default:
+		marshaled, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		data = marshaled
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the top-level value")
+	}
+	return document, nil
+}
+
+// jsonPlaceholders maps the placeholders accepted by ContainJSON to the check they
+// make on the actual value.
+var jsonPlaceholders = map[string]func(value any) bool{
+	"<any>":    func(any) bool { return true },
+	"<string>": func(value any) bool { _, ok := value.(string); return ok },
+	"<number>": func(value any) bool { _, ok := value.(json.Number); return ok },
+	"<bool>":   func(value any) bool { _, ok := value.(bool); return ok },
+	"<null>":   func(value any) bool { return value == nil },
+	"<object>": func(value any) bool { _, ok := value.(map[string]any); return ok },
+	"<array>":  func(value any) bool { _, ok := value.([]any); return ok },
+}
+
+// jsonPlaceholder is a placeholder string of the expected document, such as "<string>",
+// which matches any actual value that passes its check.
+type jsonPlaceholder string
+
+// withJSONPlaceholders returns the decoded document with its placeholder strings
+// replaced by jsonPlaceholder values.
+func withJSONPlaceholders(document any) any {
+	switch v := document.(type) {
+	case map[string]any:
+		object := make(map[string]any, len(v))
+		for key, value := range v {
+			object[key] = withJSONPlaceholders(value)
+		}
+		return object
+	case []any:
+		array := make([]any, len(v))
+		for i, value := range v {
+			array[i] = withJSONPlaceholders(value)
+		}
+		return array
+	case string:
+		if _, ok := jsonPlaceholders[v]; ok {
+			return jsonPlaceholder(v)
+		}
+	}
+	return document
+}
+
+// jsonDiffer walks two decoded JSON documents and collects their differences,
+// with JSON paths such as "$.items[2].price".
+type jsonDiffer struct {
+	cfg *Config
+}
+
+// compare compares two decoded JSON values and returns the differences found below path.
+func (d *jsonDiffer) compare(expected, actual any, path string) []fieldDiff {
+	switch e := expected.(type) {
+	case jsonPlaceholder:
+		if !jsonPlaceholders[string(e)](actual) {
+			return []fieldDiff{jsonDiff(path, expected, actual)}
+		}
+		return nil
+
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return []fieldDiff{jsonDiff(path, expected, actual)}
+		}
+		return d.compareObjects(e, a, path)
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			return []fieldDiff{jsonDiff(path, expected, actual)}
+		}
+		return d.compareArrays(e, a, path)
+
+	case json.Number:
+		a, ok := actual.(json.Number)
+		if !ok || !jsonNumbersEqual(e, a) {
+			return []fieldDiff{jsonDiff(path, expected, actual)}
+		}
+		return nil
+
+	default:
+		// Strings, booleans and null
+		if expected != actual {
+			return []fieldDiff{jsonDiff(path, expected, actual)}
+		}
+		return nil
+	}
+}
+
+// compareObjects compares two JSON objects key by key, in sorted key order.
+func (d *jsonDiffer) compareObjects(expected, actual map[string]any, path string) (diffs []fieldDiff) {
+	for _, key := range sortedJSONKeys(expected) {
+		keyPath := jsonPathKey(path, key)
+		actualValue, found := actual[key]
+		if !found {
+			diffs = append(diffs, jsonDiff(keyPath, expected[key], jsonMissing{}))
+			continue
+		}
+		diffs = append(diffs, d.compare(expected[key], actualValue, keyPath)...)
+	}
+
+	if d.cfg.IgnoreExtraFields {
+		return diffs
+	}
+	for _, key := range sortedJSONKeys(actual) {
+		if _, found := expected[key]; !found {
+			diffs = append(diffs, jsonDiff(jsonPathKey(path, key), jsonMissing{}, actual[key]))
+		}
+	}
+	return diffs
+}
+
+// compareArrays compares two JSON arrays. By default they are aligned on their equal
+// elements, like BeEqual does for slices: elements paired up between two aligned ones
+// are compared recursively and the others are reported as missing from actual or as
+// unexpected in it. WithArrayPrefix ignores the actual elements past the expected ones
+// and WithIgnoreOrder compares the arrays as multisets.
+func (d *jsonDiffer) compareArrays(expected, actual []any, path string) (diffs []fieldDiff) {
+	if jsonPathSelected(d.cfg.ArrayPrefix, d.cfg.ArrayPrefixPaths, path) && len(actual) > len(expected) {
+		actual = actual[:len(expected)]
+	}
+	if jsonPathSelected(d.cfg.IgnoreOrder, d.cfg.IgnoreOrderPaths, path) {
+		return d.compareUnorderedArrays(expected, actual, path)
+	}
+
+	matches := alignSequences(len(expected), len(actual), func(i, j int) bool {
+		return len(d.compare(expected[i], actual[j], jsonPathIndex(path, i))) == 0
+	})
+
+	for k := 1; k < len(matches); k++ {
+		fromExpected, toExpected := matches[k-1][0]+1, matches[k][0]
+		fromActual, toActual := matches[k-1][1]+1, matches[k][1]
+
+		paired := min(toExpected-fromExpected, toActual-fromActual)
+		for p := 0; p < paired; p++ {
+			diffs = append(diffs, d.compare(expected[fromExpected+p], actual[fromActual+p], jsonPathIndex(path, fromExpected+p))...)
+		}
+		for i := fromExpected + paired; i < toExpected; i++ {
+			diffs = append(diffs, jsonDiff(jsonPathIndex(path, i), expected[i], jsonMissing{}))
+		}
+		for j := fromActual + paired; j < toActual; j++ {
+			diffs = append(diffs, jsonDiff(jsonPathIndex(path, j), jsonMissing{}, actual[j]))
+		}
+	}
+	return diffs
+}
+
+// compareUnorderedArrays compares two JSON arrays as multisets. Since placeholders and
+// ignored keys let one expected element match several actual ones, the elements are
+// paired with augmenting paths rather than greedily, so that a pairing is found
+// whenever one exists.
+func (d *jsonDiffer) compareUnorderedArrays(expected, actual []any, path string) (diffs []fieldDiff) {
+	matches := make([][]bool, len(expected))
+	for i := range expected {
+		matches[i] = make([]bool, len(actual))
+		for j := range actual {
+			matches[i][j] = len(d.compare(expected[i], actual[j], jsonPathIndex(path, i))) == 0
+		}
+	}
+
+	pairedWith := make([]int, len(actual))
+	for j := range pairedWith {
+		pairedWith[j] = -1
+	}
+	var pair func(i int, visited []bool) bool
+	pair = func(i int, visited []bool) bool {
+		for j := range actual {
+			if !matches[i][j] || visited[j] {
+				continue
+			}
+			visited[j] = true
+			if pairedWith[j] < 0 || pair(pairedWith[j], visited) {
+				pairedWith[j] = i
+				return true
+			}
+		}
+		return false
+	}
+
+	paired := make([]bool, len(expected))
+	for i := range expected {
+		paired[i] = pair(i, make([]bool, len(actual)))
+	}
+
+	for i, ok := range paired {
+		if !ok {
+			diffs = append(diffs, fieldDiff{
+				Path:     jsonPathIndex(path, i),
+				Expected: expected[i],
+				Message:  "missing element " + formatJSONValue(expected[i], maxJSONValueLen),
+			})
+		}
+	}
+	for j, i := range pairedWith {
+		if i < 0 {
+			diffs = append(diffs, fieldDiff{
+				Path:    jsonPathIndex(path, j),
+				Actual:  actual[j],
+				Message: "unexpected element " + formatJSONValue(actual[j], maxJSONValueLen),
+			})
+		}
+	}
+	return diffs
+}
+
+// jsonPathSelected reports whether an option given for every array, or for the paths
+// in patterns, applies to the JSON array at path. Patterns use the syntax of
+// WithIgnoreFields, as in "items[*].tags", with or without a leading "$.".
+func jsonPathSelected(all bool, patterns []string, path string) bool {
+	if all {
+		return true
+	}
+	path = normalizePath(strings.TrimPrefix(strings.TrimPrefix(path, "$"), "."))
+	for _, pattern := range patterns {
+		if matchPath(strings.TrimPrefix(strings.TrimPrefix(pattern, "$"), "."), path) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonDiff records a difference between two JSON values, rendered as JSON.
+func jsonDiff(path string, expected, actual any) fieldDiff {
+	return fieldDiff{
+		Path:     path,
+		Expected: expected,
+		Actual:   actual,
+		Message:  fmt.Sprintf("%s ≠ %s", formatJSONValue(expected, maxJSONValueLen), formatJSONValue(actual, maxJSONValueLen)),
+	}
+}
+
+// jsonNumbersEqual reports whether two JSON numbers have the same value, so that
+// 1, 1.0 and 1e0 are equal.
+func jsonNumbersEqual(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	x, okX := new(big.Rat).SetString(a.String())
+	y, okY := new(big.Rat).SetString(b.String())
+	return okX && okY && x.Cmp(y) == 0
+}
+
+// sortedJSONKeys returns the keys of a JSON object in sorted order.
+func sortedJSONKeys(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonPathKey appends an object key to a JSON path, as ".key" or as ["key"] when the
+// key is not a plain identifier.
+func jsonPathKey(path, key string) string {
+	if jsonIdentifier.MatchString(key) {
+		return path + "." + key
+	}
+	return fmt.Sprintf("%s[%q]", path, key)
+}
+
+// jsonPathIndex appends an array index to a JSON path.
+func jsonPathIndex(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+// formatJSONValue renders a decoded JSON value as compact JSON, truncated to maxLen bytes.
+func formatJSONValue(value any, maxLen int) string {
+	switch v := value.(type) {
+	case jsonMissing:
+		return "<missing>"
+	case jsonPlaceholder:
+		return string(v)
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprint(value)
+	}
+
+	text := strings.TrimSuffix(buffer.String(), "\n")
+	if len(text) > maxLen {
+		return text[:maxLen-3] + "..."
+	}
+	return text
+}
+
+// formatJSONMismatch formats the failure message of the JSON assertions: both
+// documents, in compact form, followed by the list of differences.
+func formatJSONMismatch(header string, expected, actual any, diffs []fieldDiff) string {
+	return fmt.Sprintf("%s:\nexpected: %s\nactual  : %s\n%s",
+		header,
+		formatJSONValue(expected, maxJSONDocumentLen),
+		formatJSONValue(actual, maxJSONDocumentLen),
+		strings.TrimSuffix(formatFieldDiffs(diffs), "\n"),
+	)
+}
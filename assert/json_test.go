@@ -0,0 +1,279 @@
+package assert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// === Tests for JSON helpers ===
+
+func TestParseJSON(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Name string `json:"name"`
+	}
+
+	tests := []struct {
+		name    string
+		input   any
+		want    string
+		wantErr bool
+	}{
+		{name: "string", input: ` {"b": 1, "a": [true, null]} `, want: `{"a":[true,null],"b":1}`},
+		{name: "byte slice", input: []byte(`[1.50]`), want: `[1.50]`},
+		{name: "raw message", input: json.RawMessage(`"x"`), want: `"x"`},
+		{name: "marshaled value", input: user{Name: "Ann"}, want: `{"name":"Ann"}`},
+		{name: "invalid document", input: `{"a":`, wantErr: true},
+		{name: "trailing data", input: `{} {}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseJSON(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if text := formatJSONValue(got, maxJSONDocumentLen); text != tt.want {
+				t.Errorf("parseJSON() = %s, want %s", text, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONDiffer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		cfg      Config
+		want     []string
+	}{
+		{
+			name:     "key order, whitespace and number formats are ignored",
+			expected: `{"a": 1, "b": [1.0, 2e0], "c": {"d": null}}`,
+			actual:   `{"c":{"d":null},"b":[1,2.00],"a":1.0}`,
+		},
+		{
+			name:     "changed values",
+			expected: `{"items": [{"price": 10}, {"price": 11}, {"price": 10}], "ok": true}`,
+			actual:   `{"items": [{"price": 10}, {"price": 11}, {"price": 12}], "ok": "true"}`,
+			want:     []string{"$.items[2].price: 10 ≠ 12", `$.ok: true ≠ "true"`},
+		},
+		{
+			name:     "missing and extra keys",
+			expected: `{"id": 1, "email": "a@b.c", "user name": "ann"}`,
+			actual:   `{"id": 1, "debug": true, "user name": "bob"}`,
+			want: []string{
+				`$.email: "a@b.c" ≠ <missing>`,
+				`$["user name"]: "ann" ≠ "bob"`,
+				"$.debug: <missing> ≠ true",
+			},
+		},
+		{
+			name:     "extra keys can be ignored",
+			expected: `{"id": 1, "user": {"name": "ann"}}`,
+			actual:   `{"id": 1, "debug": true, "user": {"name": "ann", "age": 3}}`,
+			cfg:      Config{IgnoreExtraFields: true},
+		},
+		{
+			name:     "inserted array element",
+			expected: `[1, 2, 3]`,
+			actual:   `[1, 2, 9, 3]`,
+			want:     []string{"$[2]: <missing> ≠ 9"},
+		},
+		{
+			name:     "large numbers keep their precision",
+			expected: `{"id": 9007199254740993}`,
+			actual:   `{"id": 9007199254740992}`,
+			want:     []string{"$.id: 9007199254740993 ≠ 9007199254740992"},
+		},
+		{
+			name:     "arrays matched as a prefix",
+			expected: `{"a": [1, 2], "b": [1, 2]}`,
+			actual:   `{"a": [1, 2, 3], "b": [1, 2, 3]}`,
+			cfg:      Config{ArrayPrefixPaths: []string{"$.a"}},
+			want:     []string{"$.b[2]: <missing> ≠ 3"},
+		},
+		{
+			name:     "prefix still reports a short actual array",
+			expected: `[1, 2, 3]`,
+			actual:   `[1, 2]`,
+			cfg:      Config{ArrayPrefix: true},
+			want:     []string{"$[2]: 3 ≠ <missing>"},
+		},
+		{
+			name:     "arrays compared in any order",
+			expected: `{"items": [{"tags": ["a", "b"]}, {"tags": ["c"]}]}`,
+			actual:   `{"items": [{"tags": ["c"]}, {"tags": ["b", "a"]}]}`,
+			cfg:      Config{IgnoreOrderPaths: []string{"items", "items[*].tags"}},
+		},
+		{
+			name:     "unordered arrays report unpaired elements",
+			expected: `[1, 2, 3]`,
+			actual:   `[3, 4, 1]`,
+			cfg:      Config{IgnoreOrder: true},
+			want:     []string{"$[1]: missing element 2", "$[1]: unexpected element 4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			expected, _ := parseJSON(tt.expected)
+			actual, _ := parseJSON(tt.actual)
+			diffs := (&jsonDiffer{cfg: &tt.cfg}).compare(expected, actual, "$")
+
+			var got []string
+			for _, diff := range diffs {
+				got = append(got, diff.Path+": "+diff.Message)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Unexpected differences:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+// === Tests for MatchJSON ===
+
+func TestMatchJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes for equivalent documents", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchJSON(t, []byte(`{"tags":["a","b"],"id":1.0}`), map[string]any{"id": 1, "tags": []string{"a", "b"}})
+		})
+		if failed {
+			t.Errorf("Expected MatchJSON to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("reports differences as JSON paths", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchJSON(t, `{"id": 2, "extra": "x"}`, `{"id": 1}`, WithMessage("unexpected body"))
+		})
+		if !failed {
+			t.Fatal("Expected MatchJSON to fail")
+		}
+		expected := `unexpected body
+Expected JSON documents to match:
+expected: {"id":1}
+actual  : {"extra":"x","id":2}
+Field differences:
+  └─ $.id: 1 ≠ 2
+  └─ $.extra: <missing> ≠ "x"`
+		if message != expected {
+			t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+		}
+	})
+
+	t.Run("ignores extra fields when asked", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchJSON(t, `{"id": 1, "extra": "x"}`, `{"id": 1}`, WithIgnoreExtraFields())
+		})
+		if failed {
+			t.Errorf("Expected MatchJSON to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("reports invalid documents", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchJSON(t, `{"id": `, `{}`)
+		})
+		if !failed || !strings.HasPrefix(message, "MatchJSON: actual is not valid JSON: ") {
+			t.Errorf("Expected an invalid JSON error, got: %q", message)
+		}
+	})
+}
+
+// === Tests for ContainJSON ===
+
+func TestContainJSON(t *testing.T) {
+	t.Parallel()
+
+	actual := `{"id": "u-1", "age": 30, "admin": false, "manager": null,
+		"roles": [{"name": "dev", "level": 2}, {"name": "ops", "level": 1}], "tags": ["a", "b", "c"]}`
+
+	tests := []struct {
+		name     string
+		expected string
+		opts     []Option
+		want     []string
+	}{
+		{
+			name:     "extra keys are allowed at any depth",
+			expected: `{"id": "u-1", "roles": [{"name": "dev"}, {"name": "ops"}]}`,
+		},
+		{
+			name: "placeholders match values of their kind",
+			expected: `{"id": "<string>", "age": "<number>", "admin": "<bool>", "manager": "<null>",
+				"roles": "<array>", "tags": "<any>"}`,
+		},
+		{
+			name:     "placeholders reject values of another kind",
+			expected: `{"id": "<number>", "roles": [{"name": "<any>", "level": "<string>"}, "<object>"]}`,
+			want:     []string{"$.id: <number> ≠ \"u-1\"", "$.roles[0].level: <string> ≠ 2"},
+		},
+		{
+			name:     "missing keys are reported",
+			expected: `{"email": "<string>", "roles": [{"name": "dev", "team": "core"}, {}]}`,
+			want:     []string{"$.email: <string> ≠ <missing>", "$.roles[0].team: \"core\" ≠ <missing>"},
+		},
+		{
+			name:     "arrays keep their order and length by default",
+			expected: `{"tags": ["a", "b"]}`,
+			want:     []string{"$.tags[2]: <missing> ≠ \"c\""},
+		},
+		{
+			name:     "arrays matched as a prefix",
+			expected: `{"tags": ["a", "b"]}`,
+			opts:     []Option{WithArrayPrefix("tags")},
+		},
+		{
+			name:     "arrays matched in any order",
+			expected: `{"roles": [{"name": "<string>"}, {"name": "dev"}]}`,
+			opts:     []Option{WithIgnoreOrder()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			failed, message := assertFails(t, func(t testing.TB) {
+				ContainJSON(t, actual, tt.expected, tt.opts...)
+			})
+			if len(tt.want) == 0 {
+				if failed {
+					t.Errorf("Expected ContainJSON to pass, but it failed with message: %q", message)
+				}
+				return
+			}
+			if !failed {
+				t.Fatal("Expected ContainJSON to fail")
+			}
+			if !strings.HasPrefix(message, "Expected JSON to contain:\n") {
+				t.Errorf("Unexpected header in message:\n%s", message)
+			}
+			differences := message[strings.Index(message, "Field differences:\n")+len("Field differences:\n"):]
+			want := "  └─ " + strings.Join(tt.want, "\n  └─ ")
+			if differences != want {
+				t.Errorf("Unexpected differences:\n%s\nwant:\n%s", differences, want)
+			}
+		})
+	}
+}
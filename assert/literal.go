@@ -0,0 +1,397 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"math"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// modulePath is the import path of this module, whose frames are skipped when looking
+// for the test calling an assertion.
+const modulePath = "github.com/Kairum-Labs/should"
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	durationType    = reflect.TypeOf(time.Duration(0))
+	errorStringType = reflect.TypeOf(errors.New(""))
+)
+
+// formatGoLiteral renders a value as gofmt-ed Go source that evaluates to it, so that it can
+// be pasted into a test as the expected value, such as
+//
+//	&models.User{
+//		Name:    "Ann",
+//		Created: time.Date(2024, time.January, 2, 15, 4, 5, 0, time.UTC),
+//		Manager: func() *string { v := "Bob"; return &v }(),
+//	}
+//
+// Type names are qualified with their package name, except for the types of the
+// package pkg the literal is meant for. Zero fields are left out, as are unexported
+// fields unless unexported is set. Errors created by errors.New are written as such a
+// call. Funcs and channels, which have no literal form, are rendered as nil followed by
+// a comment. A value that refers back to itself, or that holds a value of a type the
+// literal cannot name, cannot be written as a literal at all, so an error is returned
+// for it.
+func formatGoLiteral(value any, pkg string, unexported bool) (string, error) {
+	w := &literalWriter{pkg: pkg, unexported: unexported, visiting: make(map[reference]string)}
+	source := w.write(reflect.ValueOf(value), nil, "", false)
+	if w.problem != "" {
+		return "", errors.New(w.problem)
+	}
+
+	formatted, err := format.Source([]byte("package p\n\nvar v = " + source + "\n"))
+	if err != nil {
+		return source, nil
+	}
+	_, literal, _ := strings.Cut(strings.TrimSpace(string(formatted)), "var v = ")
+	return literal, nil
+}
+
+// literalWriter renders values as Go source for formatGoLiteral.
+type literalWriter struct {
+	pkg        string
+	unexported bool
+	// visiting holds the path of every pointer, map and slice being written, to stop at cycles.
+	visiting map[reference]string
+	// problem describes the first part of the value that a literal cannot express,
+	// which makes the literal unusable.
+	problem string
+}
+
+// write renders v, found at path, in a place of type static, such as a struct field or
+// a slice element. A nil static type stands for an interface: the literal then has to
+// carry the type of v, as in int32(1). When elide is set, the type of a composite
+// literal is left out, as Go allows for the elements of slices, arrays and maps.
+func (w *literalWriter) write(v reflect.Value, static reflect.Type, path string, elide bool) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	if static == nil || static.Kind() == reflect.Interface {
+		// Values held in interfaces always carry their type
+		static, elide = nil, false
+	}
+
+	if ref, ok := referenceOf(v); ok {
+		if target, found := w.visiting[ref]; found {
+			w.fail("it has a cycle: %s refers back to %s", literalPath(path), literalPath(target))
+			return "nil"
+		}
+		w.visiting[ref] = path
+		defer delete(w.visiting, ref)
+	}
+
+	t := v.Type()
+	switch {
+	case static == nil && t == errorStringType:
+		return fmt.Sprintf("errors.New(%s)", inlineSnapshotLiteral(v.Interface().(error).Error()))
+	case static == nil && w.foreignUnexported(t):
+		w.fail("%s holds a %s, whose type cannot be named outside of its package", literalPath(path), t)
+		return "nil"
+	case t == timeType:
+		return timeLiteral(v.Interface().(time.Time))
+	case t == durationType:
+		return w.durationLiteral(time.Duration(v.Int()), static)
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return w.convert(t, static, strconv.FormatBool(v.Bool()), reflect.Bool)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return w.convert(t, static, strconv.FormatInt(v.Int(), 10), reflect.Int)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return w.convert(t, static, strconv.FormatUint(v.Uint(), 10), reflect.Int)
+
+	case reflect.Float32, reflect.Float64:
+		text := floatLiteral(v.Float(), t.Bits())
+		if static == nil && t.Kind() == reflect.Float64 && t.Name() == "float64" &&
+			!strings.ContainsAny(text, ".eEN(") {
+			// An untyped 1 would be an int
+			text += ".0"
+		}
+		return w.convert(t, static, text, reflect.Float64)
+
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		text := fmt.Sprintf("complex(%s, %s)", floatLiteral(real(c), 64), floatLiteral(imag(c), 64))
+		return w.convert(t, static, text, reflect.Complex128)
+
+	case reflect.String:
+		return w.convert(t, static, inlineSnapshotLiteral(v.String()), reflect.String)
+
+	case reflect.Struct:
+		v = addressable(v)
+		var fields []string
+		for i := 0; i < v.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() && !w.unexported {
+				continue
+			}
+			value := readableField(v, i)
+			if value.IsZero() {
+				continue
+			}
+			fields = append(fields, field.Name+": "+w.write(value, field.Type, path+"."+field.Name, false)+",\n")
+		}
+		return w.composite(t, elide, fields)
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return "nil"
+		}
+		switch v.Elem().Kind() {
+		case reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+			if v.Elem().Type() != timeType {
+				literal := w.write(v.Elem(), v.Elem().Type(), path, elide)
+				if elide {
+					return literal
+				}
+				return "&" + literal
+			}
+		}
+		// Pointers to other values are taken from a variable holding a copy
+		elem := v.Elem()
+		value := w.write(elem, elem.Type(), path, false)
+		return fmt.Sprintf("func() *%s { v := %s; return &v }()", w.typeName(elem.Type()), value)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return "nil"
+		}
+		return w.write(v.Elem(), nil, path, false)
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return "nil"
+		}
+		elem := t.Elem()
+		multiline := isCompositeType(elem) || elem.Kind() == reflect.Interface
+		var elements []string
+		for i := 0; i < v.Len(); i++ {
+			element := w.write(v.Index(i), elem, fmt.Sprintf("%s[%d]", path, i), true)
+			if multiline {
+				element += ",\n"
+			}
+			elements = append(elements, element)
+		}
+		if !multiline {
+			literal := "{" + strings.Join(elements, ", ") + "}"
+			if elide {
+				return literal
+			}
+			return w.typeName(t) + literal
+		}
+		return w.composite(t, elide, elements)
+
+	case reflect.Map:
+		if v.IsNil() {
+			return "nil"
+		}
+		var entries []string
+		for _, key := range sortedMapKeys(v) {
+			keyText := w.write(key, t.Key(), path, true)
+			value := w.write(v.MapIndex(key), t.Elem(), fmt.Sprintf("%s[%s]", path, keyText), true)
+			entries = append(entries, keyText+": "+value+",\n")
+		}
+		return w.composite(t, elide, entries)
+
+	default:
+		// Funcs, channels and unsafe pointers have no literal form
+		if v.IsNil() {
+			return "nil"
+		}
+		return fmt.Sprintf("nil /* %s */", strings.ReplaceAll(describeIdentity(v), "*/", "* /"))
+	}
+}
+
+// fail records why the literal cannot be used, keeping the first reason found.
+func (w *literalWriter) fail(format string, args ...any) {
+	if w.problem == "" {
+		w.problem = fmt.Sprintf(format, args...)
+	}
+}
+
+// foreignUnexported reports whether t, or the type it points to, is an unexported type
+// of another package than the one the literal is meant for.
+func (w *literalWriter) foreignUnexported(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.PkgPath() != "" && t.PkgPath() != w.pkg && !token.IsExported(t.Name())
+}
+
+// literalPath names the place of a value in the literal, "root" for the value itself.
+func literalPath(path string) string {
+	if path == "" {
+		return "root"
+	}
+	return strings.TrimPrefix(path, ".")
+}
+
+// composite renders a composite literal of type t with one item per line, leaving
+// the type out when elide is set.
+func (w *literalWriter) composite(t reflect.Type, elide bool, items []string) string {
+	literal := "{"
+	if len(items) > 0 {
+		literal += "\n" + strings.Join(items, "")
+	}
+	literal += "}"
+	if elide {
+		return literal
+	}
+	return w.typeName(t) + literal
+}
+
+// convert wraps the literal text of a value of type t in a conversion, unless the
+// place of type static already gives it that type, or text already has that type
+// as an untyped constant of the given default kind, as 1 has for int.
+func (w *literalWriter) convert(t, static reflect.Type, text string, untyped reflect.Kind) string {
+	if static != nil || (t.PkgPath() == "" && t.Kind() == untyped && t.Name() == untyped.String()) {
+		return text
+	}
+	return w.typeName(t) + "(" + text + ")"
+}
+
+// durationLiteral renders a duration as a multiple of its largest exact unit, such
+// as 1500 * time.Millisecond.
+func (w *literalWriter) durationLiteral(d time.Duration, static reflect.Type) string {
+	if d == 0 {
+		return w.convert(durationType, static, "0", reflect.Int)
+	}
+	units := []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+		{time.Microsecond, "time.Microsecond"},
+	}
+	for _, u := range units {
+		if d%u.unit == 0 {
+			if d == u.unit {
+				return u.name
+			}
+			return fmt.Sprintf("%d * %s", d/u.unit, u.name)
+		}
+	}
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
+// timeLiteral renders a time as a time.Date call, in UTC, local time, a time zone loaded
+// by name or a fixed zone with the same name and offset. A zone is loaded by name when
+// the zone of that name gives t the same offset, as Europe/Paris does.
+func timeLiteral(t time.Time) string {
+	name := t.Location().String()
+	location := fmt.Sprintf("time.FixedZone(%q, %d)", name, tzOffset(t))
+	switch t.Location() {
+	case time.UTC:
+		location = "time.UTC"
+	case time.Local:
+		location = "time.Local"
+	default:
+		if loaded, err := time.LoadLocation(name); err == nil && tzOffset(t.In(loaded)) == tzOffset(t) {
+			return fmt.Sprintf("func() time.Time { loc, _ := time.LoadLocation(%q); return %s }()", name, dateLiteral(t, "loc"))
+		}
+	}
+	return dateLiteral(t, location)
+}
+
+// dateLiteral renders a time as a time.Date call in the given location.
+func dateLiteral(t time.Time, location string) string {
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, %s)",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location)
+}
+
+// tzOffset returns the offset of the time zone of t, in seconds east of UTC.
+func tzOffset(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
+
+// floatLiteral renders a float with as many digits as needed to read it back.
+func floatLiteral(f float64, bits int) string {
+	switch {
+	case math.IsNaN(f):
+		return "math.NaN()"
+	case math.IsInf(f, 1):
+		return "math.Inf(1)"
+	case math.IsInf(f, -1):
+		return "math.Inf(-1)"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bits)
+}
+
+// typeName returns the Go syntax of a type, qualified with its package name unless it
+// belongs to the package the literal is meant for.
+func (w *literalWriter) typeName(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" || t.PkgPath() == w.pkg {
+			return t.Name()
+		}
+		return t.String()
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + w.typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + w.typeName(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), w.typeName(t.Elem()))
+	case reflect.Map:
+		return "map[" + w.typeName(t.Key()) + "]" + w.typeName(t.Elem())
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "any"
+		}
+		return t.String()
+	default:
+		return t.String()
+	}
+}
+
+// isCompositeType reports whether the values of type t are written as composite
+// literals, possibly behind a pointer.
+func isCompositeType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// callerPackage returns the import path of the package of the test calling an
+// assertion: the first caller outside of this module, or in one of its test files.
+func callerPackage() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		inModule := strings.HasPrefix(frame.Function, modulePath+".") || strings.HasPrefix(frame.Function, modulePath+"/")
+		if frame.Function != "" && (!inModule || strings.HasSuffix(frame.File, "_test.go")) {
+			name := frame.Function
+			slash := strings.LastIndex(name, "/")
+			if dot := strings.Index(name[slash+1:], "."); dot >= 0 {
+				return name[:slash+1+dot]
+			}
+			return name
+		}
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// === Tests for Go literal helpers ===
+
+type literalStatus int
+
+type literalAddress struct {
+	City string
+	Zip  *string
+}
+
+type literalUser struct {
+	Name    string
+	Age     int
+	Status  literalStatus
+	Tags    []string
+	Address *literalAddress
+	Created time.Time
+	Timeout time.Duration
+	Link    *url.URL
+	Notify  func()
+	secret  string
+}
+
+func TestFormatGoLiteral(t *testing.T) {
+	t.Parallel()
+
+	zip := "1000"
+
+	tests := []struct {
+		name       string
+		value      any
+		unexported bool
+		want       string
+	}{
+		{name: "nil", value: nil, want: "nil"},
+		{name: "basic values", value: []any{1, 2.0, int32(3), "x", true, literalStatus(2)},
+			want: "[]any{\n\t1,\n\t2.0,\n\tint32(3),\n\t\"x\",\n\ttrue,\n\tliteralStatus(2),\n}"},
+		{name: "special floats", value: []float64{math.Inf(-1), math.NaN(), 0.5}, want: "[]float64{math.Inf(-1), math.NaN(), 0.5}"},
+		{name: "durations", value: []time.Duration{0, time.Hour, 1500 * time.Millisecond, 3},
+			want: "[]time.Duration{0, time.Hour, 1500 * time.Millisecond, 3 * time.Nanosecond}"},
+		{name: "times", value: time.Date(2024, 3, 4, 5, 6, 7, 8, time.FixedZone("UTC+1", 3600)),
+			want: `time.Date(2024, time.March, 4, 5, 6, 7, 8, time.FixedZone("UTC+1", 3600))`},
+		{name: "errors", value: []error{errors.New("not found"), fmt.Errorf("line %d", 2)},
+			want: "[]error{\n\terrors.New(\"not found\"),\n\terrors.New(\"line 2\"),\n}"},
+		{name: "pointer to a basic value", value: &zip, want: `func() *string { v := "1000"; return &v }()`},
+		{
+			name: "struct",
+			value: &literalUser{
+				Name:    "Ann",
+				Status:  1,
+				Tags:    []string{"a", "b"},
+				Address: &literalAddress{City: "Lisbon", Zip: &zip},
+				Created: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+				Timeout: 30 * time.Second,
+				Link:    &url.URL{Scheme: "https", Host: "example.com"},
+				Notify:  func() {},
+				secret:  "s",
+			},
+			want: `&literalUser{
+	Name:   "Ann",
+	Status: 1,
+	Tags:   []string{"a", "b"},
+	Address: &literalAddress{
+		City: "Lisbon",
+		Zip:  func() *string { v := "1000"; return &v }(),
+	},
+	Created: time.Date(2024, time.January, 2, 15, 4, 5, 0, time.UTC),
+	Timeout: 30 * time.Second,
+	Link: &url.URL{
+		Scheme: "https",
+		Host:   "example.com",
+	},
+	Notify: nil, /* func assert.TestFormatGoLiteral.func1 */
+}`,
+		},
+		{name: "unexported fields", value: literalUser{secret: "s"}, unexported: true, want: "literalUser{\n\tsecret: \"s\",\n}"},
+		{
+			name:  "elided element types",
+			value: map[string][]*literalAddress{"b": {{City: "X"}, nil}, "a": nil},
+			want: `map[string][]*literalAddress{
+	"a": nil,
+	"b": {
+		{
+			City: "X",
+		},
+		nil,
+	},
+}`,
+		},
+		{name: "multi-line strings", value: "a\nb", want: "`a\nb`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := formatGoLiteral(tt.value, "github.com/Kairum-Labs/should/assert", tt.unexported)
+			if err != nil {
+				t.Fatalf("formatGoLiteral() returned an error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("formatGoLiteral() =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatGoLiteral_QualifiesOtherPackages(t *testing.T) {
+	t.Parallel()
+
+	got, err := formatGoLiteral([]literalAddress{{City: "X"}}, "example.com/app", false)
+	want := "[]assert.literalAddress{\n\t{\n\t\tCity: \"X\",\n\t},\n}"
+	if err != nil || got != want {
+		t.Errorf("formatGoLiteral() =\n%s\nwant:\n%s\nerror: %v", got, want, err)
+	}
+}
+
+func TestFormatGoLiteral_WritesNamedTimeZones(t *testing.T) {
+	t.Parallel()
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("Could not load timezone data")
+	}
+
+	got, err := formatGoLiteral(time.Date(2024, 7, 1, 12, 0, 0, 0, paris), "example.com/app", false)
+	want := `func() time.Time {
+	loc, _ := time.LoadLocation("Europe/Paris")
+	return time.Date(2024, time.July, 1, 12, 0, 0, 0, loc)
+}()`
+	if err != nil || got != want {
+		t.Errorf("formatGoLiteral() =\n%s\nwant:\n%s\nerror: %v", got, want, err)
+	}
+}
+
+func TestFormatGoLiteral_RejectsUnwritableValues(t *testing.T) {
+	t.Parallel()
+
+	type node struct {
+		Name string
+		Next *node
+	}
+	type result struct {
+		Err error
+	}
+	loop := &node{Name: "a", Next: &node{Name: "b"}}
+	loop.Next.Next = loop
+	nested := map[string]any{"x": 1}
+	nested["self"] = []any{nested}
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "pointer back to the root", value: loop, want: "it has a cycle: Next.Next refers back to root"},
+		{name: "pointer back to an inner value", value: []*node{loop}, want: "it has a cycle: [0].Next.Next refers back to [0]"},
+		{name: "map holding itself", value: nested, want: `it has a cycle: ["self"][0] refers back to root`},
+		{name: "wrapped error", value: result{Err: fmt.Errorf("load: %w", errors.New("not found"))},
+			want: "Err holds a *fmt.wrapError, whose type cannot be named outside of its package"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := formatGoLiteral(tt.value, "github.com/Kairum-Labs/should/assert", false)
+			if err == nil {
+				t.Fatalf("Expected an error, got:\n%s", got)
+			}
+			if err.Error() != tt.want {
+				t.Errorf("formatGoLiteral() error = %q, want %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCallerPackage(t *testing.T) {
+	t.Parallel()
+
+	if got := callerPackage(); got != "github.com/Kairum-Labs/should/assert" {
+		t.Errorf("callerPackage() = %q", got)
+	}
+}
@@ -0,0 +1,419 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Matcher describes a condition that a value can satisfy.
+//
+// Matchers can be passed to Contain, ContainValue, NotContainValue and BeOneOf in place
+// of an expected value. When no element matches, the failure message uses Explain to
+// tell why the closest element was rejected.
+type Matcher interface {
+	// Match reports whether actual satisfies the matcher.
+	Match(actual any) bool
+	// Description describes the values accepted by the matcher, e.g. ">= 18".
+	Description() string
+	// Explain describes why actual does not satisfy the matcher, e.g. "17 < 18".
+	Explain(actual any) string
+}
+
+// scorer is implemented by matchers that can tell how close a value is to matching.
+// Scores range from 0 (unrelated) to 1 (matches).
+type scorer interface {
+	score(actual any) float64
+}
+
+// matchScore returns how close actual is to satisfying m.
+func matchScore(m Matcher, actual any) float64 {
+	if s, ok := m.(scorer); ok {
+		return s.score(actual)
+	}
+	if m.Match(actual) {
+		return 1
+	}
+	return 0
+}
+
+// === Value matchers ===
+
+type equalMatcher struct {
+	expected any
+}
+
+// Equal returns a matcher that accepts values deeply equal to expected.
+func Equal(expected any) Matcher {
+	return equalMatcher{expected: expected}
+}
+
+func (m equalMatcher) Match(actual any) bool {
+	return reflect.DeepEqual(actual, m.expected)
+}
+
+func (m equalMatcher) Description() string {
+	return formatComparisonValue(m.expected)
+}
+
+func (m equalMatcher) Explain(actual any) string {
+	return fmt.Sprintf("%s ≠ %s", formatComparisonValue(actual), formatComparisonValue(m.expected))
+}
+
+func (m equalMatcher) score(actual any) float64 {
+	if m.Match(actual) {
+		return 1
+	}
+	a, aOk := toFloat64(reflect.ValueOf(actual))
+	e, eOk := toFloat64(reflect.ValueOf(m.expected))
+	if aOk && eOk {
+		return 0.9 / (1 + math.Abs(a-e))
+	}
+	as, aOk := actual.(string)
+	es, eOk := m.expected.(string)
+	if aOk && eOk {
+		return 0.9 * calculateStringSimilarity(es, as).Similarity
+	}
+	return 0
+}
+
+// orderMatcher compares numeric values against a threshold.
+type orderMatcher struct {
+	threshold any
+	operator  string // ">", ">=", "<" or "<="
+}
+
+// GreaterThan returns a matcher that accepts numbers greater than threshold.
+func GreaterThan(threshold any) Matcher {
+	return orderMatcher{threshold: threshold, operator: ">"}
+}
+
+// GreaterOrEqualTo returns a matcher that accepts numbers greater than or equal to threshold.
+func GreaterOrEqualTo(threshold any) Matcher {
+	return orderMatcher{threshold: threshold, operator: ">="}
+}
+
+// LessThan returns a matcher that accepts numbers less than threshold.
+func LessThan(threshold any) Matcher {
+	return orderMatcher{threshold: threshold, operator: "<"}
+}
+
+// LessOrEqualTo returns a matcher that accepts numbers less than or equal to threshold.
+func LessOrEqualTo(threshold any) Matcher {
+	return orderMatcher{threshold: threshold, operator: "<="}
+}
+
+// negatedOperators maps an operator to the one that holds when it does not.
+var negatedOperators = map[string]string{">": "<=", ">=": "<", "<": ">=", "<=": ">"}
+
+func (m orderMatcher) Match(actual any) bool {
+	a, t, ok := m.numbers(actual)
+	if !ok {
+		return false
+	}
+	switch m.operator {
+	case ">":
+		return a > t
+	case ">=":
+		return a >= t
+	case "<":
+		return a < t
+	default:
+		return a <= t
+	}
+}
+
+func (m orderMatcher) Description() string {
+	return fmt.Sprintf("%s %v", m.operator, m.threshold)
+}
+
+func (m orderMatcher) Explain(actual any) string {
+	if _, _, ok := m.numbers(actual); !ok {
+		return fmt.Sprintf("%s is not a number", formatComparisonValue(actual))
+	}
+	return fmt.Sprintf("%v %s %v", actual, negatedOperators[m.operator], m.threshold)
+}
+
+func (m orderMatcher) score(actual any) float64 {
+	if m.Match(actual) {
+		return 1
+	}
+	a, t, ok := m.numbers(actual)
+	if !ok {
+		return 0
+	}
+	return 0.9 / (1 + math.Abs(a-t))
+}
+
+func (m orderMatcher) numbers(actual any) (a, threshold float64, ok bool) {
+	a, aOk := toFloat64(reflect.ValueOf(actual))
+	threshold, tOk := toFloat64(reflect.ValueOf(m.threshold))
+	return a, threshold, aOk && tOk
+}
+
+type toleranceMatcher struct {
+	expected  float64
+	tolerance float64
+}
+
+// WithinTolerance returns a matcher that accepts numbers within tolerance of expected.
+func WithinTolerance(expected, tolerance float64) Matcher {
+	return toleranceMatcher{expected: expected, tolerance: tolerance}
+}
+
+func (m toleranceMatcher) Match(actual any) bool {
+	a, ok := toFloat64(reflect.ValueOf(actual))
+	return ok && math.Abs(a-m.expected) <= m.tolerance
+}
+
+func (m toleranceMatcher) Description() string {
+	return fmt.Sprintf("%v ± %v", m.expected, m.tolerance)
+}
+
+func (m toleranceMatcher) Explain(actual any) string {
+	a, ok := toFloat64(reflect.ValueOf(actual))
+	if !ok {
+		return fmt.Sprintf("%s is not a number", formatComparisonValue(actual))
+	}
+	return fmt.Sprintf("%v differs from %v by %v (tolerance %v)", actual, m.expected, math.Abs(a-m.expected), m.tolerance)
+}
+
+func (m toleranceMatcher) score(actual any) float64 {
+	a, ok := toFloat64(reflect.ValueOf(actual))
+	if !ok {
+		return 0
+	}
+	if m.Match(actual) {
+		return 1
+	}
+	return 0.9 / (1 + math.Abs(a-m.expected) - m.tolerance)
+}
+
+// === Combinators ===
+
+type allOfMatcher struct {
+	matchers []Matcher
+}
+
+// AllOf returns a matcher that accepts values accepted by every given matcher.
+func AllOf(matchers ...Matcher) Matcher {
+	return allOfMatcher{matchers: matchers}
+}
+
+func (m allOfMatcher) Match(actual any) bool {
+	for _, matcher := range m.matchers {
+		if !matcher.Match(actual) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m allOfMatcher) Description() string {
+	return "all of (" + describeMatchers(m.matchers) + ")"
+}
+
+func (m allOfMatcher) Explain(actual any) string {
+	var reasons []string
+	for _, matcher := range m.matchers {
+		if !matcher.Match(actual) {
+			reasons = append(reasons, matcher.Explain(actual))
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
+
+func (m allOfMatcher) score(actual any) float64 {
+	if len(m.matchers) == 0 {
+		return 1
+	}
+	total := 0.0
+	for _, matcher := range m.matchers {
+		total += matchScore(matcher, actual)
+	}
+	return total / float64(len(m.matchers))
+}
+
+type anyOfMatcher struct {
+	matchers []Matcher
+}
+
+// AnyOf returns a matcher that accepts values accepted by at least one of the given matchers.
+func AnyOf(matchers ...Matcher) Matcher {
+	return anyOfMatcher{matchers: matchers}
+}
+
+func (m anyOfMatcher) Match(actual any) bool {
+	for _, matcher := range m.matchers {
+		if matcher.Match(actual) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m anyOfMatcher) Description() string {
+	return "any of (" + describeMatchers(m.matchers) + ")"
+}
+
+func (m anyOfMatcher) Explain(actual any) string {
+	reasons := make([]string, 0, len(m.matchers))
+	for _, matcher := range m.matchers {
+		reasons = append(reasons, matcher.Explain(actual))
+	}
+	return "none matched: " + strings.Join(reasons, "; ")
+}
+
+func (m anyOfMatcher) score(actual any) float64 {
+	best := 0.0
+	for _, matcher := range m.matchers {
+		best = math.Max(best, matchScore(matcher, actual))
+	}
+	return best
+}
+
+type notMatcher struct {
+	matcher Matcher
+}
+
+// Not returns a matcher that accepts values rejected by the given matcher.
+func Not(matcher Matcher) Matcher {
+	return notMatcher{matcher: matcher}
+}
+
+func (m notMatcher) Match(actual any) bool {
+	return !m.matcher.Match(actual)
+}
+
+func (m notMatcher) Description() string {
+	return "not " + m.matcher.Description()
+}
+
+func (m notMatcher) Explain(actual any) string {
+	return fmt.Sprintf("%s matched %s", formatComparisonValue(actual), m.matcher.Description())
+}
+
+type fieldMatcher struct {
+	path     string
+	expected Matcher
+}
+
+// HasField returns a matcher that accepts structs whose field at path satisfies expected.
+//
+// The path may refer to nested fields using dots, such as "Address.City". Pointers are
+// followed automatically. If expected is not a Matcher, the field must be deeply equal to it.
+func HasField(path string, expected any) Matcher {
+	m, ok := expected.(Matcher)
+	if !ok {
+		m = Equal(expected)
+	}
+	return fieldMatcher{path: path, expected: m}
+}
+
+func (m fieldMatcher) Match(actual any) bool {
+	field, err := fieldByPath(actual, m.path)
+	return err == nil && m.expected.Match(field)
+}
+
+func (m fieldMatcher) Description() string {
+	return joinFieldPath(m.path, m.expected.Description())
+}
+
+func (m fieldMatcher) Explain(actual any) string {
+	field, err := fieldByPath(actual, m.path)
+	if err != nil {
+		return err.Error()
+	}
+	return joinFieldPath(m.path, m.expected.Explain(field))
+}
+
+func (m fieldMatcher) score(actual any) float64 {
+	field, err := fieldByPath(actual, m.path)
+	if err != nil {
+		return 0
+	}
+	return matchScore(m.expected, field)
+}
+
+// joinFieldPath prefixes a description with a field path, merging nested paths
+// so that ".Address" and ".City x" read as ".Address.City x".
+func joinFieldPath(path, text string) string {
+	if strings.HasPrefix(text, ".") {
+		return "." + path + text
+	}
+	return "." + path + " " + text
+}
+
+// fieldByPath resolves a dotted field path on a struct, following pointers and interfaces.
+func fieldByPath(value any, path string) (any, error) {
+	v := reflect.ValueOf(value)
+	for _, name := range strings.Split(path, ".") {
+		for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+			if v.IsNil() {
+				return nil, fmt.Errorf(".%s: cannot read field %s of nil", path, name)
+			}
+			v = v.Elem()
+		}
+		if !v.IsValid() || v.Kind() != reflect.Struct {
+			return nil, fmt.Errorf(".%s: cannot read field %s of %s", path, name, formatComparisonValue(value))
+		}
+		field, ok := v.Type().FieldByName(name)
+		if !ok {
+			return nil, fmt.Errorf(".%s: %s has no field %s", path, v.Type(), name)
+		}
+		if !field.IsExported() {
+			return nil, fmt.Errorf(".%s: field %s of %s is unexported", path, name, v.Type())
+		}
+		v = v.FieldByIndex(field.Index)
+	}
+	return v.Interface(), nil
+}
+
+func describeMatchers(matchers []Matcher) string {
+	descriptions := make([]string, 0, len(matchers))
+	for _, matcher := range matchers {
+		descriptions = append(descriptions, matcher.Description())
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// closestMatch finds the element of values that comes closest to satisfying m.
+// It returns -1 if values is empty.
+func closestMatch(m Matcher, values []any) int {
+	best, bestScore := -1, -1.0
+	for i, value := range values {
+		if score := matchScore(m, value); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// matchesAny reports whether any of the values satisfies m.
+func matchesAny(m Matcher, values []any) bool {
+	for _, value := range values {
+		if m.Match(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// sliceElements returns the elements of a slice or array with their index labels.
+func sliceElements(v reflect.Value) (labels []string, values []any) {
+	for i := 0; i < v.Len(); i++ {
+		labels = append(labels, fmt.Sprintf("[%d]", i))
+		values = append(values, v.Index(i).Interface())
+	}
+	return labels, values
+}
+
+// mapElements returns the values of a map with their key labels, in sorted key order.
+func mapElements(v reflect.Value) (labels []string, values []any) {
+	for _, key := range sortedMapKeys(v) {
+		labels = append(labels, fmt.Sprintf("[%s]", formatComparisonValue(key.Interface())))
+		values = append(values, v.MapIndex(key).Interface())
+	}
+	return labels, values
+}
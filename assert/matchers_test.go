@@ -0,0 +1,360 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+type matcherUser struct {
+	Name    string
+	Age     int
+	Address *matcherAddress
+}
+
+type matcherAddress struct {
+	City string
+}
+
+// === Tests for Matcher implementations ===
+
+func TestMatchers(t *testing.T) {
+	t.Parallel()
+
+	adult := matcherUser{Name: "Ann", Age: 30, Address: &matcherAddress{City: "Lisbon"}}
+	minor := matcherUser{Name: "Bob", Age: 17}
+
+	tests := []struct {
+		name        string
+		matcher     Matcher
+		actual      any
+		match       bool
+		description string
+		explain     string
+	}{
+		{
+			name:        "Equal matches deeply equal values",
+			matcher:     Equal([]int{1, 2}),
+			actual:      []int{1, 2},
+			match:       true,
+			description: "[1, 2]",
+		},
+		{
+			name:        "Equal explains inequality",
+			matcher:     Equal("John"),
+			actual:      "Jon",
+			description: `"John"`,
+			explain:     `"Jon" ≠ "John"`,
+		},
+		{
+			name:        "GreaterThan rejects the threshold",
+			matcher:     GreaterThan(18),
+			actual:      18,
+			description: "> 18",
+			explain:     "18 <= 18",
+		},
+		{
+			name:        "GreaterOrEqualTo accepts the threshold",
+			matcher:     GreaterOrEqualTo(18),
+			actual:      18,
+			match:       true,
+			description: ">= 18",
+		},
+		{
+			name:        "LessThan compares across numeric types",
+			matcher:     LessThan(2.5),
+			actual:      3,
+			description: "< 2.5",
+			explain:     "3 >= 2.5",
+		},
+		{
+			name:        "LessOrEqualTo rejects non-numbers",
+			matcher:     LessOrEqualTo(10),
+			actual:      "ten",
+			description: "<= 10",
+			explain:     `"ten" is not a number`,
+		},
+		{
+			name:        "WithinTolerance accepts close values",
+			matcher:     WithinTolerance(3.14, 0.01),
+			actual:      3.141,
+			match:       true,
+			description: "3.14 ± 0.01",
+		},
+		{
+			name:        "WithinTolerance explains the distance",
+			matcher:     WithinTolerance(10, 1),
+			actual:      12,
+			description: "10 ± 1",
+			explain:     "12 differs from 10 by 2 (tolerance 1)",
+		},
+		{
+			name:        "Not inverts a matcher",
+			matcher:     Not(Equal("guest")),
+			actual:      "guest",
+			description: `not "guest"`,
+			explain:     `"guest" matched "guest"`,
+		},
+		{
+			name:        "AllOf explains every failing matcher",
+			matcher:     AllOf(GreaterThan(0), LessThan(10), Not(Equal(20))),
+			actual:      20,
+			description: `all of (> 0, < 10, not 20)`,
+			explain:     "20 >= 10; 20 matched 20",
+		},
+		{
+			name:        "AnyOf matches when one matcher does",
+			matcher:     AnyOf(Equal(200), Equal(204)),
+			actual:      204,
+			match:       true,
+			description: "any of (200, 204)",
+		},
+		{
+			name:        "AnyOf explains every matcher",
+			matcher:     AnyOf(Equal(200), Equal(204)),
+			actual:      500,
+			description: "any of (200, 204)",
+			explain:     "none matched: 500 ≠ 200; 500 ≠ 204",
+		},
+		{
+			name:        "HasField explains the field",
+			matcher:     HasField("Age", GreaterOrEqualTo(18)),
+			actual:      minor,
+			description: ".Age >= 18",
+			explain:     ".Age 17 < 18",
+		},
+		{
+			name:        "HasField compares plain values for equality",
+			matcher:     HasField("Name", "Ann"),
+			actual:      &adult,
+			match:       true,
+			description: `.Name "Ann"`,
+		},
+		{
+			name:        "HasField follows nested pointers",
+			matcher:     HasField("Address.City", "Porto"),
+			actual:      adult,
+			description: `.Address.City "Porto"`,
+			explain:     `.Address.City "Lisbon" ≠ "Porto"`,
+		},
+		{
+			name:        "HasField reports nil pointers",
+			matcher:     HasField("Address.City", "Porto"),
+			actual:      minor,
+			description: `.Address.City "Porto"`,
+			explain:     ".Address.City: cannot read field City of nil",
+		},
+		{
+			name:        "HasField reports missing fields",
+			matcher:     HasField("Email", "a@b.c"),
+			actual:      minor,
+			description: `.Email "a@b.c"`,
+			explain:     ".Email: assert.matcherUser has no field Email",
+		},
+		{
+			name:        "HasField nests",
+			matcher:     HasField("Address", HasField("City", "Porto")),
+			actual:      adult,
+			description: `.Address.City "Porto"`,
+			explain:     `.Address.City "Lisbon" ≠ "Porto"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.matcher.Match(tt.actual); got != tt.match {
+				t.Errorf("Match() = %v, want %v", got, tt.match)
+			}
+			if got := tt.matcher.Description(); got != tt.description {
+				t.Errorf("Description() = %q, want %q", got, tt.description)
+			}
+			if tt.match {
+				return
+			}
+			if got := tt.matcher.Explain(tt.actual); got != tt.explain {
+				t.Errorf("Explain() = %q, want %q", got, tt.explain)
+			}
+		})
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	t.Parallel()
+
+	values := []any{
+		matcherUser{Name: "Ann", Age: 12},
+		matcherUser{Name: "Bob", Age: 17},
+		matcherUser{Name: "Cid", Age: 5},
+	}
+
+	tests := []struct {
+		name    string
+		matcher Matcher
+		want    int
+	}{
+		{name: "nearest number", matcher: HasField("Age", GreaterOrEqualTo(18)), want: 1},
+		{name: "most matchers satisfied", matcher: AllOf(HasField("Name", "Cid"), HasField("Age", GreaterThan(10))), want: 2},
+		{name: "similar string", matcher: HasField("Name", "Anne"), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := closestMatch(tt.matcher, values); got != tt.want {
+				t.Errorf("closestMatch() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if got := closestMatch(Equal(1), nil); got != -1 {
+		t.Errorf("closestMatch() on empty values = %d, want -1", got)
+	}
+}
+
+// === Tests for assertions accepting matchers ===
+
+func TestContain_WithMatcher(t *testing.T) {
+	t.Parallel()
+
+	users := []matcherUser{{Name: "Ann", Age: 12}, {Name: "Bob", Age: 17}}
+
+	t.Run("passes when an element matches", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			Contain(t, users, HasField("Name", "Bob"))
+		})
+		if failed {
+			t.Errorf("Expected Contain to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("explains the closest element", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			Contain(t, users, HasField("Age", GreaterOrEqualTo(18)))
+		})
+		if !failed {
+			t.Fatal("Expected Contain to fail")
+		}
+		expected := `Expected collection to contain element matching:
+  Collection: [matcherUser{Name: "Ann", Age: 12, Address: nil}, matcherUser{Name: "Bob", Age: 17, Address: nil}]
+  Matcher   : .Age >= 18
+  Closest   : [1] matcherUser{Name: "Bob", Age: 17, Address: nil}
+  Because   : .Age 17 < 18`
+		if message != expected {
+			t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+		}
+	})
+}
+
+func TestContainValueMatching(t *testing.T) {
+	t.Parallel()
+
+	ages := map[string]int{"ann": 12, "bob": 17}
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		ContainValueMatching(t, ages, GreaterOrEqualTo(18))
+	})
+	if !failed {
+		t.Fatal("Expected ContainValueMatching to fail")
+	}
+	for _, part := range []string{"Expected map to contain value matching:", `Closest: ["bob"] 17`, "Because: 17 < 18"} {
+		if !strings.Contains(message, part) {
+			t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+		}
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		ContainValueMatching(t, ages, LessThan(15))
+	})
+	if failed {
+		t.Errorf("Expected ContainValueMatching to pass, but it failed with message: %q", message)
+	}
+}
+
+func TestAnyMatching(t *testing.T) {
+	t.Parallel()
+
+	numbers := []int{1, 3, 5}
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		AnyMatching(t, numbers, AnyOf(Equal(4), Equal(6)))
+	})
+	if !failed {
+		t.Fatal("Expected AnyMatching to fail")
+	}
+	expectedParts := []string{
+		"Expected at least one item to match:",
+		"Closest   : [1] 3",
+		"Because   : none matched: 3 ≠ 4; 3 ≠ 6",
+	}
+	for _, part := range expectedParts {
+		if !strings.Contains(message, part) {
+			t.Errorf("Expected message to contain %q, got:\n%s", part, message)
+		}
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		AnyMatching(t, numbers, AllOf(GreaterThan(2), LessThan(4)))
+	})
+	if failed {
+		t.Errorf("Expected AnyMatching to pass, but it failed with message: %q", message)
+	}
+}
+
+func TestBeOneOf_WithMatchers(t *testing.T) {
+	t.Parallel()
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		BeOneOf[any](t, 17, []any{0, GreaterOrEqualTo(18)})
+	})
+	if !failed {
+		t.Fatal("Expected BeOneOf to fail")
+	}
+	expected := `Expected value to be one of the allowed options:
+Value   : 17
+Options : [0, >= 18]
+Count   : 0 of 2 options matched
+Reasons :
+  - 0: 17 ≠ 0
+  - >= 18: 17 < 18`
+	if message != expected {
+		t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		BeOneOf[any](t, 21, []any{0, GreaterOrEqualTo(18)})
+	})
+	if failed {
+		t.Errorf("Expected BeOneOf to pass, but it failed with message: %q", message)
+	}
+}
+
+func TestNotContainValueMatching(t *testing.T) {
+	t.Parallel()
+
+	ages := map[string]int{"ann": 12, "bob": 40, "cid": -3}
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		NotContainValueMatching(t, ages, LessThan(18))
+	})
+	if !failed {
+		t.Fatal("Expected NotContainValueMatching to fail")
+	}
+	expected := `Expected map to NOT contain value matching:
+  Map    : map["ann": 12, "bob": 40, "cid": -3]
+  Matcher: < 18
+  Found  : ["ann"] 12
+  Found  : ["cid"] -3`
+	if message != expected {
+		t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		NotContainValueMatching(t, ages, GreaterThan(40))
+	})
+	if failed {
+		t.Errorf("Expected NotContainValueMatching to pass, but it failed with message: %q", message)
+	}
+}
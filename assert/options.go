@@ -2,6 +2,10 @@ package assert
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
 	"time"
 )
 
@@ -17,6 +21,28 @@ type Config struct {
 	IgnoreCase bool
 	StackTrace bool
 	Time       TimeOptions
+	// MaxFailures stops a Group once this many failures were recorded. Zero means no limit.
+	MaxFailures int
+	// IgnoreFields lists the field paths skipped when comparing values for equality.
+	IgnoreFields []string
+	// UnexportedFields makes the difference report descend into unexported struct fields.
+	UnexportedFields bool
+	// IgnoreOrder compares every slice and array regardless of element order.
+	IgnoreOrder bool
+	// IgnoreOrderPaths lists the paths of the slices compared regardless of element order.
+	IgnoreOrderPaths []string
+	// IgnoreExtraFields makes JSON comparisons ignore object keys only present in actual.
+	IgnoreExtraFields bool
+	// ArrayPrefix makes every expected JSON array match the beginning of the actual one.
+	ArrayPrefix bool
+	// ArrayPrefixPaths lists the paths of the JSON arrays matched as a prefix.
+	ArrayPrefixPaths []string
+	// Scrubbers rewrite strings before they are compared, to mask volatile content.
+	Scrubbers []func(string) string
+	// Comparers holds the equality functions registered with WithComparer, by type.
+	Comparers map[reflect.Type]func(a, b any) bool
+	// GoLiteral makes BeEqual failures show the actual value as a Go literal.
+	GoLiteral bool
 	/*
 		 	Description    string
 			DeepComparison bool
@@ -43,6 +69,36 @@ type ignoreTimezone bool
 // truncateDuration configures time comparisons to truncate both values before comparing
 type truncateDuration time.Duration
 
+// maxFailures configures how many failures a Group records before it stops
+type maxFailures int
+
+// ignoreFields lists field paths to skip when comparing values
+type ignoreFields []string
+
+// unexportedFields makes the difference report include unexported struct fields
+type unexportedFields bool
+
+// ignoreOrder lists the paths of slices to compare as multisets, or none for every slice
+type ignoreOrder []string
+
+// ignoreExtraFields makes JSON comparisons ignore keys only present in actual
+type ignoreExtraFields bool
+
+// arrayPrefix lists the paths of JSON arrays to match as a prefix, or none for every array
+type arrayPrefix []string
+
+// scrubber masks volatile content in strings before they are compared
+type scrubber func(string) string
+
+// comparer registers an equality function for the values of one type
+type comparer struct {
+	typ   reflect.Type
+	equal func(a, b any) bool
+}
+
+// goLiteral shows the actual value of a failed BeEqual as a Go literal
+type goLiteral bool
+
 // Apply sets the custom message in the config.
 func (m message) Apply(c *Config) {
 	c.Message = string(m)
@@ -66,6 +122,62 @@ func (u truncateDuration) Apply(c *Config) {
 	c.Time.TruncateUnit = time.Duration(u)
 }
 
+// Apply implements Option for maxFailures
+func (m maxFailures) Apply(c *Config) {
+	c.MaxFailures = int(m)
+}
+
+// Apply implements Option for ignoreFields
+func (f ignoreFields) Apply(c *Config) {
+	c.IgnoreFields = append(c.IgnoreFields, f...)
+}
+
+// Apply implements Option for unexportedFields
+func (u unexportedFields) Apply(c *Config) {
+	c.UnexportedFields = bool(u)
+}
+
+// Apply implements Option for ignoreOrder
+func (o ignoreOrder) Apply(c *Config) {
+	if len(o) == 0 {
+		c.IgnoreOrder = true
+		return
+	}
+	c.IgnoreOrderPaths = append(c.IgnoreOrderPaths, o...)
+}
+
+// Apply implements Option for ignoreExtraFields
+func (i ignoreExtraFields) Apply(c *Config) {
+	c.IgnoreExtraFields = bool(i)
+}
+
+// Apply implements Option for arrayPrefix
+func (p arrayPrefix) Apply(c *Config) {
+	if len(p) == 0 {
+		c.ArrayPrefix = true
+		return
+	}
+	c.ArrayPrefixPaths = append(c.ArrayPrefixPaths, p...)
+}
+
+// Apply implements Option for scrubber
+func (s scrubber) Apply(c *Config) {
+	c.Scrubbers = append(c.Scrubbers, s)
+}
+
+// Apply implements Option for comparer
+func (c comparer) Apply(config *Config) {
+	if config.Comparers == nil {
+		config.Comparers = make(map[reflect.Type]func(a, b any) bool)
+	}
+	config.Comparers[c.typ] = c.equal
+}
+
+// Apply implements Option for goLiteral
+func (g goLiteral) Apply(c *Config) {
+	c.GoLiteral = bool(g)
+}
+
 // WithMessage creates an option for setting a custom error message.
 //
 // The message is treated as a plain string literal. Use this when you
@@ -117,3 +229,101 @@ func WithIgnoreTimezone() Option {
 func WithTruncate(unit time.Duration) Option {
 	return truncateDuration(unit)
 }
+
+// WithMaxFailures stops a Group as soon as n failures have been recorded.
+//
+// The failures collected up to that point are still reported in the summary.
+// A value of zero or less lets the group run every check.
+func WithMaxFailures(n int) Option {
+	return maxFailures(n)
+}
+
+// WithIgnoreFields excludes the given field paths from equality comparisons.
+//
+// Paths use the dotted syntax shown in the difference report, such as "ID",
+// "Meta.CreatedAt" or "Items[*].UpdatedAt", where "[*]" matches any slice index
+// or map key. Ignoring a field also ignores everything nested below it.
+func WithIgnoreFields(paths ...string) Option {
+	return ignoreFields(paths)
+}
+
+// WithUnexportedFields makes equality comparisons report differences in unexported
+// struct fields, which are read through reflection. Without it, values that differ
+// only in unexported fields are still unequal, but the report just names those fields.
+func WithUnexportedFields() Option {
+	return unexportedFields(true)
+}
+
+// WithIgnoreOrder makes equality comparisons treat slices and arrays as multisets,
+// so that the same elements in a different order are equal. Without paths, it applies
+// to every slice at any depth; otherwise only to the slices at the given paths.
+// MatchJSON and ContainJSON apply it to JSON arrays.
+func WithIgnoreOrder(paths ...string) Option {
+	return ignoreOrder(paths)
+}
+
+// WithComparer makes equality comparisons use the given function for values of type T,
+// wherever they appear, instead of comparing them field by field.
+func WithComparer[T any](equal func(a, b T) bool) Option {
+	return comparer{
+		typ: reflect.TypeOf((*T)(nil)).Elem(),
+		equal: func(a, b any) bool {
+			return equal(a.(T), b.(T))
+		},
+	}
+}
+
+// WithIgnoreExtraFields makes MatchJSON ignore object keys that are only present in
+// the actual document, at any depth.
+func WithIgnoreExtraFields() Option {
+	return ignoreExtraFields(true)
+}
+
+// WithArrayPrefix makes MatchJSON and ContainJSON accept actual arrays that start with
+// the expected elements and continue with more. Without paths, it applies to every
+// array at any depth; otherwise only to the arrays at the given paths.
+func WithArrayPrefix(paths ...string) Option {
+	return arrayPrefix(paths)
+}
+
+// uuidPattern matches UUIDs in their canonical textual form.
+var uuidPattern = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+
+// timestampPattern matches RFC 3339 timestamps and the output of time.Time.String.
+var timestampPattern = regexp.MustCompile(
+	`\b\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:\d{2}| [+-]\d{4}(?: [A-Z][A-Za-z]{1,4}\b)?)?`)
+
+// WithScrubber makes string comparisons replace every match of pattern with replacement
+// before comparing, in BeEqual on strings, ContainSubstring and the snapshot assertions.
+// The replacement may refer to submatches, as in regexp.Regexp.ReplaceAllString.
+// Failure messages show the scrubbed strings. Scrubbers run in the order they are given.
+func WithScrubber(pattern *regexp.Regexp, replacement string) Option {
+	return scrubber(func(s string) string {
+		return pattern.ReplaceAllString(s, replacement)
+	})
+}
+
+// WithScrubUUIDs is a scrubber that replaces UUIDs with "<UUID>".
+func WithScrubUUIDs() Option {
+	return WithScrubber(uuidPattern, "<UUID>")
+}
+
+// WithScrubTimestamps is a scrubber that replaces RFC 3339 timestamps, and times
+// formatted by time.Time.String, with "<TIMESTAMP>".
+func WithScrubTimestamps() Option {
+	return WithScrubber(timestampPattern, "<TIMESTAMP>")
+}
+
+// WithScrubTempDir is a scrubber that replaces the temporary directory, along with the
+// directory created in it by t.TempDir or os.MkdirTemp, with "<TMPDIR>".
+func WithScrubTempDir() Option {
+	dir := regexp.QuoteMeta(filepath.Clean(os.TempDir()))
+	return WithScrubber(regexp.MustCompile(dir+`(?:[/\\][^/\\\s"'`+"`"+`]+|\b)`), "<TMPDIR>")
+}
+
+// WithGoLiteral makes BeEqual failures also show the actual value as gofmt-ed Go
+// source, with qualified type names, pointers and time.Date calls, ready to be pasted
+// into the test as the new expected value.
+func WithGoLiteral() Option {
+	return goLiteral(true)
+}
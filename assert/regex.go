@@ -0,0 +1,206 @@
+package assert
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode/utf8"
+)
+
+// compileRegex returns the regular expression given to the regex assertions, either as a
+// *regexp.Regexp or as a string holding the pattern.
+func compileRegex(pattern any) (*regexp.Regexp, error) {
+	switch p := pattern.(type) {
+	case *regexp.Regexp:
+		if p == nil {
+			return nil, fmt.Errorf("pattern is a nil *regexp.Regexp")
+		}
+		return p, nil
+	case string:
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		return re, nil
+	default:
+		return nil, fmt.Errorf("pattern must be a string or a *regexp.Regexp, but got %T", pattern)
+	}
+}
+
+// regexPrefix is a prefix of a regular expression, as a regular expression itself, in
+// which the capture groups cut short by the prefix are still closed.
+type regexPrefix struct {
+	re *syntax.Regexp
+	// complete reports whether the prefix is the whole expression it was taken from.
+	complete bool
+	// partial lists the indexes of the capture groups cut short by the prefix.
+	partial []int
+}
+
+// regexPrefixes returns the prefixes of a parsed regular expression, from the shortest
+// to the whole expression. Concatenations and literals are cut between their elements,
+// descending into capture groups, and x{n} starts with fewer repetitions of x; other
+// expressions, such as alternations, can only be taken whole, so that every prefix only
+// matches the beginning of what the expression matches.
+func regexPrefixes(re *syntax.Regexp) []regexPrefix {
+	switch re.Op {
+	case syntax.OpConcat:
+		var prefixes []regexPrefix
+		for i, sub := range re.Sub {
+			for _, p := range regexPrefixes(sub) {
+				subs := append(append([]*syntax.Regexp{}, re.Sub[:i]...), p.re)
+				prefixes = append(prefixes, regexPrefix{
+					re:       &syntax.Regexp{Op: syntax.OpConcat, Flags: re.Flags, Sub: subs},
+					complete: p.complete && i == len(re.Sub)-1,
+					partial:  p.partial,
+				})
+			}
+		}
+		return prefixes
+
+	case syntax.OpCapture:
+		var prefixes []regexPrefix
+		for _, p := range regexPrefixes(re.Sub[0]) {
+			partial := p.partial
+			if !p.complete {
+				partial = append([]int{re.Cap}, partial...)
+			}
+			capture := &syntax.Regexp{Op: syntax.OpCapture, Flags: re.Flags, Cap: re.Cap, Name: re.Name, Sub: []*syntax.Regexp{p.re}}
+			prefixes = append(prefixes, regexPrefix{re: capture, complete: p.complete, partial: partial})
+		}
+		return prefixes
+
+	case syntax.OpLiteral:
+		prefixes := make([]regexPrefix, len(re.Rune))
+		for i := range re.Rune {
+			prefixes[i] = regexPrefix{
+				re:       &syntax.Regexp{Op: syntax.OpLiteral, Flags: re.Flags, Rune: re.Rune[:i+1]},
+				complete: i == len(re.Rune)-1,
+			}
+		}
+		return prefixes
+
+	case syntax.OpRepeat:
+		// x{3,5} starts with x and x{2}
+		var prefixes []regexPrefix
+		for n := 1; n < re.Min; n++ {
+			prefix := re.Sub[0]
+			if n > 1 {
+				prefix = &syntax.Regexp{Op: syntax.OpRepeat, Flags: re.Flags, Min: n, Max: n, Sub: re.Sub}
+			}
+			prefixes = append(prefixes, regexPrefix{re: prefix})
+		}
+		return append(prefixes, regexPrefix{re: re, complete: true})
+
+	default:
+		return []regexPrefix{{re: re, complete: true}}
+	}
+}
+
+// regexPrefixSource returns the beginning of the pattern source that a prefix of the
+// pattern stands for, searching from offset from. The source is cut at every rune and
+// the capture groups left open are closed, until it parses to the same expression as
+// the prefix. It returns the prefix as printed by regexp/syntax when no cut does.
+func regexPrefixSource(source string, from int, prefix *syntax.Regexp, flags syntax.Flags) (string, int) {
+	want := prefix.String()
+	for end := from; end <= len(source); end++ {
+		if end < len(source) && !utf8.RuneStart(source[end]) {
+			continue
+		}
+		for closing := 0; closing <= strings.Count(source[:end], "("); closing++ {
+			re, err := syntax.Parse(source[:end]+strings.Repeat(")", closing), flags)
+			if err == nil && re.String() == want {
+				// Keep the groups that the source closes right away
+				for closing > 0 && end < len(source) && source[end] == ')' {
+					end++
+					closing--
+				}
+				return source[:end], end
+			}
+			if err == nil {
+				break
+			}
+		}
+	}
+	return want, from
+}
+
+// formatRegexMismatch explains why a string does not match a regular expression: the
+// longest prefix of the pattern that matches somewhere in the string, where that match
+// stopped, and the named capture groups it filled, even partially.
+func formatRegexMismatch(re *regexp.Regexp, actual string) string {
+	var builder strings.Builder
+	builder.WriteString("Expected string to match pattern:\n")
+	fmt.Fprintf(&builder, "Pattern : %s\n", re.String())
+	fmt.Fprintf(&builder, "Actual  : %q", actual)
+
+	flags := syntax.Perl
+	parsed, err := syntax.Parse(re.String(), flags)
+	if err != nil {
+		return builder.String()
+	}
+
+	var longest *regexPrefix
+	var longestRe *regexp.Regexp
+	var longestSource string
+	sourceEnd := 0
+	for _, prefix := range regexPrefixes(parsed) {
+		if prefix.complete {
+			break
+		}
+		source, end := regexPrefixSource(re.String(), sourceEnd, prefix.re, flags)
+		prefixRe, err := regexp.Compile(prefix.re.String())
+		if err != nil || !prefixRe.MatchString(actual) {
+			break
+		}
+		longest, longestRe, longestSource, sourceEnd = &prefix, prefixRe, source, end
+	}
+
+	if longest == nil {
+		builder.WriteString("\nMatched : nothing, the pattern fails from its first element")
+		return builder.String()
+	}
+
+	match := longestRe.FindStringSubmatchIndex(actual)
+	fmt.Fprintf(&builder, "\nMatched : %s (matches %q)", longestSource, actual[match[0]:match[1]])
+	if match[1] == len(actual) {
+		fmt.Fprintf(&builder, "\nStopped : at position %d, the end of the string", match[1])
+	} else {
+		fmt.Fprintf(&builder, "\nStopped : at position %d, before %q", match[1], truncateRegexInput(actual[match[1]:]))
+	}
+
+	var groups []string
+	for i, name := range longestRe.SubexpNames() {
+		if name == "" || match[2*i] < 0 {
+			continue
+		}
+		group := fmt.Sprintf("%s = %q", name, actual[match[2*i]:match[2*i+1]])
+		for _, partial := range longest.partial {
+			if partial == i {
+				group += " (partial)"
+			}
+		}
+		groups = append(groups, group)
+	}
+	if len(groups) > 0 {
+		fmt.Fprintf(&builder, "\nGroups  : %s", strings.Join(groups, ", "))
+	}
+	return builder.String()
+}
+
+// truncateRegexInput shortens the rest of the input shown after a failed match.
+func truncateRegexInput(s string) string {
+	runes := []rune(s)
+	if len(runes) > 20 {
+		return string(runes[:20]) + "..."
+	}
+	return s
+}
+
+// formatRegexMatch explains why a string unexpectedly matches a regular expression.
+func formatRegexMatch(re *regexp.Regexp, actual string) string {
+	match := re.FindStringIndex(actual)
+	return fmt.Sprintf("Expected string not to match pattern:\nPattern : %s\nActual  : %q\nMatch   : %q at position %d",
+		re.String(), actual, actual[match[0]:match[1]], match[0])
+}
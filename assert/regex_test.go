@@ -0,0 +1,194 @@
+package assert
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// === Tests for regex helpers ===
+
+func TestCompileRegex(t *testing.T) {
+	t.Parallel()
+
+	compiled := regexp.MustCompile(`^a+$`)
+	tests := []struct {
+		name    string
+		pattern any
+		want    string
+		wantErr string
+	}{
+		{name: "string pattern", pattern: `\d+`, want: `\d+`},
+		{name: "compiled pattern", pattern: compiled, want: `^a+$`},
+		{name: "invalid pattern", pattern: `(`, wantErr: "invalid pattern: error parsing regexp: missing closing ): `(`"},
+		{name: "nil pattern", pattern: (*regexp.Regexp)(nil), wantErr: "pattern is a nil *regexp.Regexp"},
+		{name: "other type", pattern: 42, wantErr: "pattern must be a string or a *regexp.Regexp, but got int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			re, err := compileRegex(tt.pattern)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("compileRegex() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || re.String() != tt.want {
+				t.Errorf("compileRegex() = %v, %v, want %s", re, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRegexMismatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pattern string
+		actual  string
+		want    string
+	}{
+		{
+			name:    "stops inside a literal",
+			pattern: `hello world`,
+			actual:  "hello wordl",
+			want: `Matched : hello wor (matches "hello wor")
+Stopped : at position 9, before "dl"`,
+		},
+		{
+			name:    "reports partially matched named groups",
+			pattern: `^(?P<year>\d{4})-(?P<month>\d{2})$`,
+			actual:  "2024-1x",
+			want: `Matched : ^(?P<year>\d{4})-(?P<month>\d (matches "2024-1")
+Stopped : at position 6, before "x"
+Groups  : year = "2024", month = "1" (partial)`,
+		},
+		{
+			name:    "stops at the end of the string",
+			pattern: `id=(?P<id>\d+);`,
+			actual:  "user id=42",
+			want: `Matched : id=(?P<id>\d+) (matches "id=42")
+Stopped : at position 10, the end of the string
+Groups  : id = "42"`,
+		},
+		{
+			name:    "alternations are taken whole",
+			pattern: `^(?:cat|dog)s$`,
+			actual:  "cow",
+			want:    `Matched : ^ (matches "")` + "\n" + `Stopped : at position 0, before "cow"`,
+		},
+		{
+			name:    "nothing matches",
+			pattern: `x|y`,
+			actual:  "abc",
+			want:    "Matched : nothing, the pattern fails from its first element",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := formatRegexMismatch(regexp.MustCompile(tt.pattern), tt.actual)
+			header := "Expected string to match pattern:\nPattern : " + tt.pattern + "\nActual  : \"" + tt.actual + "\"\n"
+			if got != header+tt.want {
+				t.Errorf("formatRegexMismatch() =\n%s\nwant:\n%s", got, header+tt.want)
+			}
+		})
+	}
+}
+
+// === Tests for MatchRegex, MatchRegexSubmatches and NotMatchRegex ===
+
+func TestMatchRegex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes for a match anywhere in the string", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchRegex(t, "order #42 shipped", `#\d+`)
+			MatchRegex(t, "usr_0123456789ab", regexp.MustCompile(`^usr_[a-z0-9]{12}$`))
+		})
+		if failed {
+			t.Errorf("Expected MatchRegex to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("explains where matching stopped", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchRegex(t, "usr_ABC", `^usr_[a-z0-9]{12}$`, WithMessage("invalid user ID"))
+		})
+		if !failed {
+			t.Fatal("Expected MatchRegex to fail")
+		}
+		expected := `invalid user ID
+Expected string to match pattern:
+Pattern : ^usr_[a-z0-9]{12}$
+Actual  : "usr_ABC"
+Matched : ^usr_ (matches "usr_")
+Stopped : at position 4, before "ABC"`
+		if message != expected {
+			t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+		}
+	})
+
+	t.Run("reports invalid patterns", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchRegex(t, "abc", `[a-`)
+		})
+		if !failed || !strings.HasPrefix(message, "MatchRegex: invalid pattern: ") {
+			t.Errorf("Expected an invalid pattern error, got: %q", message)
+		}
+	})
+}
+
+func TestMatchRegexSubmatches(t *testing.T) {
+	t.Parallel()
+
+	var submatches []string
+	failed, message := assertFails(t, func(t testing.TB) {
+		submatches, _ = MatchRegexSubmatches(t, "/orders/42/items/7", `^/orders/(\d+)/items/(\d+)$`)
+	})
+	if failed {
+		t.Errorf("Expected MatchRegexSubmatches to pass, but it failed with message: %q", message)
+	}
+	if strings.Join(submatches, ",") != "/orders/42/items/7,42,7" {
+		t.Errorf("Unexpected submatches: %q", submatches)
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		submatches, _ = MatchRegexSubmatches(t, "/orders/x", `^/orders/(\d+)$`)
+	})
+	if !failed || len(submatches) != 2 || submatches[1] != "" || !strings.HasPrefix(message, "Expected string to match pattern:") {
+		t.Errorf("Expected MatchRegexSubmatches to fail with empty submatches, got %q: %q", submatches, message)
+	}
+}
+
+func TestNotMatchRegex(t *testing.T) {
+	t.Parallel()
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		NotMatchRegex(t, "user=ann", `(?i)password=\S+`)
+	})
+	if failed {
+		t.Errorf("Expected NotMatchRegex to pass, but it failed with message: %q", message)
+	}
+
+	failed, message = assertFails(t, func(t testing.TB) {
+		NotMatchRegex(t, "user=ann Password=hunter2", `(?i)password=\S+`)
+	})
+	if !failed {
+		t.Fatal("Expected NotMatchRegex to fail")
+	}
+	expected := `Expected string not to match pattern:
+Pattern : (?i)password=\S+
+Actual  : "user=ann Password=hunter2"
+Match   : "Password=hunter2" at position 9`
+	if message != expected {
+		t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+	}
+}
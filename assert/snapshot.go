@@ -0,0 +1,472 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+)
+
+// snapshotDir is the directory, relative to the package under test, that holds the
+// snapshot files of MatchSnapshot.
+const snapshotDir = "testdata/snapshots"
+
+// snapshotExt is the extension of snapshot files.
+const snapshotExt = ".golden"
+
+// snapshotUpdateHint tells how to accept a new snapshot value.
+const snapshotUpdateHint = "run the tests with SHOULD_UPDATE=1"
+
+// snapshots tracks the snapshot files used while the tests of a package run.
+var snapshots = struct {
+	mu    sync.Mutex
+	calls map[testing.TB]int // number of MatchSnapshot calls made by each test
+	used  map[string]bool    // paths of the snapshot files checked or written
+}{
+	calls: make(map[testing.TB]int),
+	used:  make(map[string]bool),
+}
+
+// MatchSnapshot reports a test failure if the value does not match the snapshot stored
+// in testdata/snapshots, in a file named after the test, such as
+// testdata/snapshots/TestUser/admin.golden for the subtest "admin" of TestUser. Further
+// calls in the same test use the files TestUser/admin_2.golden, TestUser/admin_3.golden...
+//
+// Strings and byte slices are stored as they are; other values are stored as indented
+// JSON, with sorted map keys, so that snapshots are deterministic. A mismatch is shown
+// as a line diff.
+//
+// When the tests run with SHOULD_UPDATE=1 in the environment, snapshot files are written
+// instead of checked. This package does not register any flag, but if the test package
+// defines an -update flag, as golden file tests often do with
+// flag.Bool("update", false, "..."), running the tests with -update does the same. Use
+// RunSnapshots in TestMain to report snapshot files that no test uses anymore.
+//
+// Example:
+//
+//	should.MatchSnapshot(t, renderInvoice(order))
+//
+//	should.MatchSnapshot(t, user, should.WithMessage("user payload changed"))
+func MatchSnapshot(t testing.TB, actual any, opts ...Option) bool {
+	t.Helper()
+
+	cfg := processOptions(opts...)
+	content, err := serializeSnapshot(actual)
+	if err != nil {
+		failWithOptions(t, cfg, "MatchSnapshot: cannot serialize value: %v", err)
+		return false
+	}
+	content = scrubString(cfg, content)
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+
+	path := snapshotPath(t)
+	message, err := checkSnapshot(path, content, updatingSnapshots())
+	if err != nil {
+		failWithOptions(t, cfg, "MatchSnapshot: %v", err)
+		return false
+	}
+	if message != "" {
+		failWithOptions(t, cfg, "%s", message)
+		return false
+	}
+	return true
+}
+
+// RunSnapshots runs the tests of a package, like m.Run, then reports the snapshot files
+// that no test used and makes the run fail, since go test hides the output of passing
+// packages. When snapshots are being updated, with SHOULD_UPDATE=1 or an -update flag
+// defined by the test package, those files are removed instead. Files are
+// only reported after a full run, not when tests are selected with -run or -skip, or
+// skipped with -short.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(should.RunSnapshots(m))
+//	}
+func RunSnapshots(m *testing.M) int {
+	code := m.Run()
+	if partialTestRun() {
+		return code
+	}
+
+	snapshots.mu.Lock()
+	orphans, err := orphanedSnapshots(snapshotDir, snapshots.used)
+	snapshots.mu.Unlock()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "RunSnapshots: %v\n", err)
+		return code
+	}
+
+	update := updatingSnapshots()
+	for _, path := range orphans {
+		if !update {
+			fmt.Fprintf(os.Stderr, "Orphaned snapshot %s is not used by any test; %s to remove it\n", path, snapshotUpdateHint)
+			code = max(code, 1)
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			fmt.Fprintf(os.Stderr, "RunSnapshots: %v\n", err)
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "Removed orphaned snapshot %s\n", path)
+	}
+	return code
+}
+
+// MatchInlineSnapshot reports a test failure if the value does not match the snapshot
+// given inline, as a string literal in the test source.
+//
+// The value is serialized as in MatchSnapshot, without a trailing newline. When the tests
+// run with SHOULD_UPDATE=1 in the environment, or with an -update flag defined by the test
+// package, the literal of each mismatching call is rewritten with the new value and the
+// test file is formatted with gofmt; the call site is found with runtime.Caller. Values
+// spanning several lines are written as raw string literals.
+//
+// Example:
+//
+//	should.MatchInlineSnapshot(t, user.DisplayName(), "Ann Smith (admin)")
+//
+//	should.MatchInlineSnapshot(t, user, `{
+//	  "name": "Ann"
+//	}`)
+func MatchInlineSnapshot(t testing.TB, actual any, expected string, opts ...Option) bool {
+	t.Helper()
+	return matchInlineSnapshot(t, actual, expected, updatingSnapshots(), opts...)
+}
+
+// matchInlineSnapshot implements MatchInlineSnapshot, rewriting the test source on a
+// mismatch only when update is set.
+func matchInlineSnapshot(t testing.TB, actual any, expected string, update bool, opts ...Option) bool {
+	t.Helper()
+
+	cfg := processOptions(opts...)
+	content, err := serializeSnapshot(actual)
+	if err != nil {
+		failWithOptions(t, cfg, "MatchInlineSnapshot: cannot serialize value: %v", err)
+		return false
+	}
+	content = scrubString(cfg, content)
+	if content == expected {
+		return true
+	}
+
+	if update {
+		path, line, ok := inlineSnapshotCaller()
+		if !ok {
+			failWithOptions(t, cfg, "MatchInlineSnapshot: cannot locate the call site")
+			return false
+		}
+		if err := updateInlineSnapshot(path, line, content); err != nil {
+			failWithOptions(t, cfg, "MatchInlineSnapshot: %v", err)
+			return false
+		}
+		return true
+	}
+
+	var diff string
+	if needsLineDiff(expected, content) {
+		diff = formatLineDiff(expected, content)
+	} else {
+		diff = fmt.Sprintf("expected: %q\nactual  : %q", expected, content)
+	}
+	failWithOptions(t, cfg, "Inline snapshot does not match:\n%s\n\nIf the change is expected, %s to accept it.",
+		diff, snapshotUpdateHint)
+	return false
+}
+
+// updatingSnapshots reports whether snapshot files are written rather than checked: when
+// SHOULD_UPDATE is set, or when the test package defined an -update flag and it is set.
+func updatingSnapshots() bool {
+	if update, err := strconv.ParseBool(os.Getenv("SHOULD_UPDATE")); err == nil && update {
+		return true
+	}
+	if f := flag.Lookup("update"); f != nil {
+		update, _ := strconv.ParseBool(f.Value.String())
+		return update
+	}
+	return false
+}
+
+// partialTestRun reports whether only some of the tests of the package were run, so that
+// unused snapshot files may still belong to the others.
+func partialTestRun() bool {
+	for _, name := range []string{"test.run", "test.skip"} {
+		if f := flag.Lookup(name); f != nil && f.Value.String() != "" {
+			return true
+		}
+	}
+	return testing.Short()
+}
+
+// snapshotPath returns the path of the snapshot file of the next MatchSnapshot call made
+// by t, and records it as used.
+func snapshotPath(t testing.TB) string {
+	snapshots.mu.Lock()
+	defer snapshots.mu.Unlock()
+
+	calls := snapshots.calls[t] + 1
+	snapshots.calls[t] = calls
+	if calls == 1 {
+		t.Cleanup(func() {
+			snapshots.mu.Lock()
+			delete(snapshots.calls, t)
+			snapshots.mu.Unlock()
+		})
+	}
+
+	name := snapshotName(t.Name())
+	if calls > 1 {
+		name += "_" + strconv.Itoa(calls)
+	}
+	path := filepath.Join(snapshotDir, filepath.FromSlash(name)+snapshotExt)
+	snapshots.used[path] = true
+	return path
+}
+
+// snapshotName turns a test name into a relative file path without its extension: each
+// subtest becomes a directory and characters that are unsafe in file names become '_'.
+func snapshotName(testName string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '/':
+			return r
+		default:
+			return '_'
+		}
+	}, testName)
+}
+
+// serializeSnapshot renders a value as the content of a snapshot: strings and byte
+// slices as they are, other values as indented JSON.
+func serializeSnapshot(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// checkSnapshot compares content with the snapshot file at path, or writes it there when
+// update is true. It returns the failure message of a mismatch or of a missing file.
+func checkSnapshot(path, content string, update bool) (string, error) {
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return "", err
+		}
+		return "", os.WriteFile(path, []byte(content), 0o644)
+	}
+
+	stored, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Sprintf("Snapshot %s does not exist; %s to create it", path, snapshotUpdateHint), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if string(stored) == content {
+		return "", nil
+	}
+
+	diff := formatLineDiff(strings.TrimSuffix(string(stored), "\n"), strings.TrimSuffix(content, "\n"))
+	return fmt.Sprintf("Snapshot %s does not match:\n%s\n\nIf the change is expected, %s to accept it.",
+		path, diff, snapshotUpdateHint), nil
+}
+
+// orphanedSnapshots returns, in sorted order, the snapshot files under dir that are not
+// in used.
+func orphanedSnapshots(dir string, used map[string]bool) ([]string, error) {
+	var orphans []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && strings.HasSuffix(path, snapshotExt) && !used[path] {
+			orphans = append(orphans, path)
+		}
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	sort.Strings(orphans)
+	return orphans, err
+}
+
+// inlineSnapshots holds the test files whose inline snapshots are being updated.
+var inlineSnapshots = struct {
+	mu    sync.Mutex
+	files map[string]*inlineSnapshotFile
+}{
+	files: make(map[string]*inlineSnapshotFile),
+}
+
+// inlineSnapshotFile is a test file whose inline snapshots are being updated. Every
+// rewrite applies to the original source, whose line numbers are the ones reported by
+// runtime.Caller, and the file is written again with all the rewrites made so far.
+type inlineSnapshotFile struct {
+	original []byte
+	fset     *token.FileSet
+	file     *ast.File
+	literals map[*ast.BasicLit]string // new source text of the rewritten literals
+}
+
+// inlineSnapshotCaller returns the file and line of the call to MatchInlineSnapshot made
+// from the test, skipping the wrappers of the should and must packages.
+func inlineSnapshotCaller() (string, int, bool) {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasSuffix(frame.Function, "/should.MatchInlineSnapshot") &&
+			!strings.HasSuffix(frame.Function, "/should/assert.matchInlineSnapshot") &&
+			!strings.HasSuffix(frame.Function, "/should/must.MatchInlineSnapshot") &&
+			!strings.HasSuffix(frame.Function, "/should/assert.MatchInlineSnapshot") {
+			return frame.File, frame.Line, frame.File != ""
+		}
+		if !more {
+			return "", 0, false
+		}
+	}
+}
+
+// updateInlineSnapshot rewrites the expected literal of the MatchInlineSnapshot call at
+// the given line of a test file so that it holds content.
+func updateInlineSnapshot(path string, line int, content string) error {
+	inlineSnapshots.mu.Lock()
+	defer inlineSnapshots.mu.Unlock()
+
+	f, ok := inlineSnapshots.files[path]
+	if !ok {
+		original, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, path, original, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		f = &inlineSnapshotFile{original: original, fset: fset, file: file, literals: make(map[*ast.BasicLit]string)}
+		inlineSnapshots.files[path] = f
+	}
+
+	literal, err := findInlineSnapshot(f.fset, f.file, line)
+	if err != nil {
+		return fmt.Errorf("%s:%d: %w", filepath.Base(path), line, err)
+	}
+	f.literals[literal] = inlineSnapshotLiteral(content)
+
+	source, err := f.rewrite()
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, source, info.Mode())
+}
+
+// rewrite returns the original source with every rewritten literal replaced, formatted
+// with gofmt.
+func (f *inlineSnapshotFile) rewrite() ([]byte, error) {
+	literals := make([]*ast.BasicLit, 0, len(f.literals))
+	for literal := range f.literals {
+		literals = append(literals, literal)
+	}
+	sort.Slice(literals, func(i, j int) bool { return literals[i].Pos() < literals[j].Pos() })
+
+	var source bytes.Buffer
+	offset := 0
+	for _, literal := range literals {
+		start := f.fset.Position(literal.Pos()).Offset
+		end := f.fset.Position(literal.End()).Offset
+		source.Write(f.original[offset:start])
+		source.WriteString(f.literals[literal])
+		offset = end
+	}
+	source.Write(f.original[offset:])
+	return format.Source(source.Bytes())
+}
+
+// findInlineSnapshot returns the expected literal of the MatchInlineSnapshot call that
+// spans the given line.
+func findInlineSnapshot(fset *token.FileSet, file *ast.File, line int) (*ast.BasicLit, error) {
+	var calls []*ast.CallExpr
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || fset.Position(call.Pos()).Line > line || fset.Position(call.End()).Line < line {
+			return true
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			ok = fun.Sel.Name == "MatchInlineSnapshot"
+		case *ast.Ident:
+			ok = fun.Name == "MatchInlineSnapshot"
+		default:
+			ok = false
+		}
+		if ok {
+			calls = append(calls, call)
+		}
+		return true
+	})
+
+	switch {
+	case len(calls) == 0:
+		return nil, errors.New("no MatchInlineSnapshot call found")
+	case len(calls) > 1:
+		return nil, errors.New("several MatchInlineSnapshot calls found on the same line")
+	case len(calls[0].Args) < 3:
+		return nil, errors.New("the MatchInlineSnapshot call has no expected value")
+	}
+	literal, ok := calls[0].Args[2].(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return nil, errors.New("the expected value must be a string literal to be updated")
+	}
+	return literal, nil
+}
+
+// inlineSnapshotLiteral returns the Go string literal of a snapshot: a raw string for
+// values spanning several lines, when they can be written as one, a quoted string
+// otherwise.
+func inlineSnapshotLiteral(content string) string {
+	if !strings.Contains(content, "\n") || !utf8.ValidString(content) {
+		return strconv.Quote(content)
+	}
+	for _, r := range content {
+		if r == '`' || r == '\uFEFF' || (r < ' ' && r != '\n' && r != '\t') {
+			return strconv.Quote(content)
+		}
+	}
+	return "`" + content + "`"
+}
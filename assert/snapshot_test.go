@@ -0,0 +1,301 @@
+package assert
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(RunSnapshots(m))
+}
+
+// === Tests for snapshot helpers ===
+
+func TestSnapshotName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		testName string
+		want     string
+	}{
+		{testName: "TestUser", want: "TestUser"},
+		{testName: "TestUser/admin_role", want: "TestUser/admin_role"},
+		{testName: "TestUser/id:42#01", want: "TestUser/id_42_01"},
+		{testName: "TestUser/../x", want: "TestUser/__/x"},
+	}
+
+	for _, tt := range tests {
+		if got := snapshotName(tt.testName); got != tt.want {
+			t.Errorf("snapshotName(%q) = %q, want %q", tt.testName, got, tt.want)
+		}
+	}
+}
+
+func TestSerializeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Name  string         `json:"name"`
+		Attrs map[string]int `json:"attrs"`
+	}
+
+	tests := []struct {
+		name    string
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{name: "string", value: "a\nb", want: "a\nb"},
+		{name: "string ending with a newline", value: "a\n", want: "a\n"},
+		{name: "byte slice", value: []byte("<html>"), want: "<html>"},
+		{
+			name:  "struct with sorted map keys",
+			value: user{Name: "<Ann>", Attrs: map[string]int{"b": 2, "a": 1}},
+			want:  "{\n  \"name\": \"<Ann>\",\n  \"attrs\": {\n    \"a\": 1,\n    \"b\": 2\n  }\n}",
+		},
+		{name: "unsupported value", value: make(chan int), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := serializeSnapshot(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("serializeSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("serializeSnapshot() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckSnapshot(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "TestUser", "admin.golden")
+
+	message, err := checkSnapshot(path, "name: Ann\n", false)
+	if err != nil || !strings.HasPrefix(message, "Snapshot "+path+" does not exist; run the tests with SHOULD_UPDATE=1") {
+		t.Errorf("Expected a missing snapshot message, got: %q, %v", message, err)
+	}
+
+	if message, err := checkSnapshot(path, "name: Ann\nage: 30\n", true); message != "" || err != nil {
+		t.Fatalf("Expected the snapshot to be written, got: %q, %v", message, err)
+	}
+	if message, err := checkSnapshot(path, "name: Ann\nage: 30\n", false); message != "" || err != nil {
+		t.Errorf("Expected the snapshot to match, got: %q, %v", message, err)
+	}
+
+	message, err = checkSnapshot(path, "name: Ann\nage: 31\n", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "Snapshot " + path + ` does not match:
+--- expected
++++ actual
+@@ -1,2 +1,2 @@
+ name: Ann
+-age: 30
++age: 31
+
+If the change is expected, run the tests with SHOULD_UPDATE=1 to accept it.`
+	if message != expected {
+		t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+	}
+}
+
+func TestOrphanedSnapshots(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"TestA.golden", "TestB/case.golden", "TestB/case_2.golden", "notes.txt"} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	used := map[string]bool{filepath.Join(dir, "TestB", "case.golden"): true}
+	orphans, err := orphanedSnapshots(dir, used)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []string{filepath.Join(dir, "TestA.golden"), filepath.Join(dir, "TestB", "case_2.golden")}
+	if strings.Join(orphans, "\n") != strings.Join(want, "\n") {
+		t.Errorf("orphanedSnapshots() = %v, want %v", orphans, want)
+	}
+
+	if orphans, err := orphanedSnapshots(filepath.Join(dir, "missing"), used); orphans != nil || err != nil {
+		t.Errorf("Expected no orphans for a missing directory, got: %v, %v", orphans, err)
+	}
+}
+
+func TestInlineSnapshotLiteral(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{content: "single line", want: `"single line"`},
+		{content: "{\n  \"a\": 1\n}", want: "`{\n  \"a\": 1\n}`"},
+		{content: "a\n`b`", want: `"a\n` + "`b`" + `"`},
+		{content: "a\r\nb", want: `"a\r\nb"`},
+	}
+
+	for _, tt := range tests {
+		if got := inlineSnapshotLiteral(tt.content); got != tt.want {
+			t.Errorf("inlineSnapshotLiteral(%q) = %s, want %s", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestUpdateInlineSnapshot(t *testing.T) {
+	t.Parallel()
+
+	source := `package example
+
+func TestExample(t *testing.T) {
+	should.MatchInlineSnapshot(t, name, "old")
+	should.MatchInlineSnapshot(t, user,
+		"{}")
+	should.MatchInlineSnapshot(t, count, "3")
+	should.MatchInlineSnapshot(t, other, expected)
+}
+`
+	path := filepath.Join(t.TempDir(), "example_test.go")
+	if err := os.WriteFile(path, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Both rewrites use the line numbers of the original source.
+	if err := updateInlineSnapshot(path, 5, "{\n  \"name\": \"Ann\"\n}"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := updateInlineSnapshot(path, 4, "new"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "package example\n\nfunc TestExample(t *testing.T) {\n" +
+		"\tshould.MatchInlineSnapshot(t, name, \"new\")\n" +
+		"\tshould.MatchInlineSnapshot(t, user,\n\t\t`{\n  \"name\": \"Ann\"\n}`)\n" +
+		"\tshould.MatchInlineSnapshot(t, count, \"3\")\n" +
+		"\tshould.MatchInlineSnapshot(t, other, expected)\n}\n"
+	if string(got) != want {
+		t.Errorf("Unexpected source:\n%s\nwant:\n%s", got, want)
+	}
+
+	err = updateInlineSnapshot(path, 8, "x")
+	if err == nil || !strings.Contains(err.Error(), "example_test.go:8: the expected value must be a string literal") {
+		t.Errorf("Expected a non-literal error, got: %v", err)
+	}
+	err = updateInlineSnapshot(path, 2, "x")
+	if err == nil || !strings.Contains(err.Error(), "no MatchInlineSnapshot call found") {
+		t.Errorf("Expected a missing call error, got: %v", err)
+	}
+}
+
+// === Tests for MatchSnapshot ===
+
+func TestMatchSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("matches stored values", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchSnapshot(t, map[string]any{"name": "Ann", "roles": []string{"admin", "dev"}})
+			MatchSnapshot(t, "second snapshot of the test")
+		})
+		if failed {
+			t.Errorf("Expected MatchSnapshot to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("reports values that cannot be serialized", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchSnapshot(t, func() {})
+		})
+		if !failed || !strings.HasPrefix(message, "MatchSnapshot: cannot serialize value: ") {
+			t.Errorf("Expected a serialization error, got: %q", message)
+		}
+	})
+}
+
+func TestMatchInlineSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes for matching values", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			MatchInlineSnapshot(t, "Ann", "Ann")
+			MatchInlineSnapshot(t, map[string]int{"b": 2, "a": 1}, `{
+  "a": 1,
+  "b": 2
+}`)
+		})
+		if failed {
+			t.Errorf("Expected MatchInlineSnapshot to pass, but it failed with message: %q", message)
+		}
+	})
+
+	t.Run("shows single-line values", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			// Never rewrite this file, even when the tests run with SHOULD_UPDATE=1
+			matchInlineSnapshot(t, 42, "41", false)
+		})
+		if !failed {
+			t.Fatal("Expected MatchInlineSnapshot to fail")
+		}
+		expected := `Inline snapshot does not match:
+expected: "41"
+actual  : "42"
+
+If the change is expected, run the tests with SHOULD_UPDATE=1 to accept it.`
+		if message != expected {
+			t.Errorf("Unexpected message:\n%s\nwant:\n%s", message, expected)
+		}
+	})
+
+	t.Run("shows a line diff for multi-line values", func(t *testing.T) {
+		t.Parallel()
+		failed, message := assertFails(t, func(t testing.TB) {
+			matchInlineSnapshot(t, []string{"a", "c"}, "[\n  \"a\",\n  \"b\"\n]", false)
+		})
+		if !failed || !strings.Contains(message, "@@ -1,4 +1,4 @@\n [\n   \"a\",\n-  \"b\"\n+  \"c\"\n ]") {
+			t.Errorf("Expected a line diff, got:\n%s", message)
+		}
+	})
+}
+
+func TestMatchInlineSnapshot_WithScrubbers(t *testing.T) {
+	t.Parallel()
+
+	type event struct {
+		ID string    `json:"id"`
+		At time.Time `json:"at"`
+	}
+	value := event{ID: "3f2504e0-4f89-11d3-9a0c-0305e82c3301", At: time.Date(2024, 5, 1, 10, 20, 30, 0, time.UTC)}
+
+	failed, message := assertFails(t, func(t testing.TB) {
+		MatchInlineSnapshot(t, value, `{
+  "id": "<UUID>",
+  "at": "<TIMESTAMP>"
+}`, WithScrubUUIDs(), WithScrubTimestamps())
+	})
+	if failed {
+		t.Errorf("Expected MatchInlineSnapshot to pass, but it failed with message: %q", message)
+	}
+}
@@ -1,15 +1,20 @@
 package assert
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
 	"reflect"
+	"runtime"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 	"unicode/utf8"
+	"unsafe"
 )
 
 // maxSimilarLen limits substring length for similarity checks.
@@ -62,66 +67,368 @@ func formatComparisonValue(obj interface{}) string {
 // formatValueComparison handles the formatting logic for different reflect.Value types
 // to provide consistent and readable output for comparison purposes.
 func formatValueComparison(v reflect.Value) string {
+	return (&printer{}).print(v)
+}
+
+// formatPrettyValue formats a value like formatComparisonValue, but indents the
+// structs, maps and slices too long to fit on a line.
+func formatPrettyValue(obj interface{}) string {
+	return (&printer{maxWidth: prettyWidth}).print(reflect.ValueOf(obj))
+}
+
+// prettyWidth is the line length past which formatPrettyValue indents values.
+const prettyWidth = 80
+
+// reference identifies the memory a pointer, map or slice refers to. Slices of the
+// same array with different lengths are different references.
+type reference struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// referenceOf returns the reference held by a non-nil pointer, map or non-empty slice.
+// Other values cannot refer back to a value that contains them.
+func referenceOf(v reflect.Value) (reference, bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map:
+		if !v.IsNil() {
+			return reference{ptr: v.Pointer(), typ: v.Type()}, true
+		}
+	case reflect.Slice:
+		if v.Len() > 0 {
+			return reference{ptr: v.Pointer(), typ: v.Type(), len: v.Len()}, true
+		}
+	}
+	return reference{}, false
+}
+
+// sortedMapKeys returns the keys of a map in a stable order, so that maps are walked
+// and rendered the same way on every run: numbers, strings and booleans in their
+// natural order, and other keys in the order of their formatted value. The keys of
+// maps with interface keys are grouped by kind first.
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	type entry struct {
+		key, value reflect.Value
+		text       string
+	}
+	entries := make([]entry, 0, v.Len())
+	for _, key := range v.MapKeys() {
+		value := key
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+		e := entry{key: key, value: value}
+		if !isPrimitive(value.Kind()) {
+			e.text = formatValueComparison(value)
+		}
+		entries = append(entries, e)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].value, entries[j].value
+		if a.Kind() != b.Kind() {
+			return a.Kind() < b.Kind()
+		}
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.String:
+			return a.String() < b.String()
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		default:
+			return entries[i].text < entries[j].text
+		}
+	})
+
+	keys := make([]reflect.Value, len(entries))
+	for i, e := range entries {
+		keys[i] = e.key
+	}
+	return keys
+}
+
+// printer renders values for failure messages. All the formatters of this package
+// use one, with the limits suited to where the value is shown, as in
+//
+//	&User{Name: "Ann", Address: Address{...}, Tags: ["a", "b", ... (3 more)]}
+//
+// Structs are shown with their type name, and pointers to structs with "&".
+type printer struct {
+	// maxDepth is the depth from which structs, maps and slices are elided, as in
+	// "Address{...}" or "[]string(3 items)". Zero means no limit.
+	maxDepth int
+	// maxElements limits the elements shown of a slice, array or map.
+	maxElements int
+	// maxString limits the length of the strings shown, in runes.
+	maxString int
+	// maxLength is the length from which the remaining fields and elements of a
+	// value are elided as "...".
+	maxLength int
+	// maxWidth is the line length past which values are indented on several lines.
+	// Zero keeps every value on one line.
+	maxWidth int
+
+	// visiting holds the path of every pointer, map and slice being printed, such as
+	// ".Children[0]", so that a value referring back to one of them is printed as
+	// "<cycle to .Children[0]>" instead of being printed forever.
+	visiting map[reference]string
+}
+
+// printNode is a printed value: either a single piece of text, such as "42", or a
+// composite value made of its opening, such as "User{", its items and its closing.
+type printNode struct {
+	text      string
+	composite bool
+	items     []printItem
+	close     string
+	// length is the length of the items, used to enforce maxLength.
+	length int
+	// truncated reports whether items were elided because of maxLength.
+	truncated bool
+}
+
+// printItem is a field, element or map entry of a composite value, with its label
+// such as "Name: ".
+type printItem struct {
+	label string
+	node  *printNode
+}
+
+// print renders v according to the limits of the printer.
+func (p *printer) print(v reflect.Value) string {
+	p.visiting = make(map[reference]string)
+	return p.layout(p.node(v, "", 0), "", 0)
+}
+
+// node builds the printed form of v, found at path and depth in the value printed.
+func (p *printer) node(v reflect.Value, path string, depth int) *printNode {
 	if !v.IsValid() {
-		return "nil"
+		return &printNode{text: "nil"}
+	}
+
+	if ref, ok := referenceOf(v); ok {
+		if target, found := p.visiting[ref]; found {
+			if target == "" {
+				target = "root"
+			}
+			return &printNode{text: fmt.Sprintf("<cycle to %s>", target)}
+		}
+		p.visiting[ref] = path
+		defer delete(p.visiting, ref)
 	}
 
+	if str, ok := methodString(v); ok {
+		return &printNode{text: str}
+	}
+
+	elided := p.maxDepth > 0 && depth >= p.maxDepth
+
 	switch v.Kind() {
 	case reflect.Struct:
-		var parts []string
 		t := v.Type()
+		name := t.Name()
+		if elided {
+			if name == "" {
+				name = "struct"
+			}
+			if v.IsZero() {
+				return &printNode{text: name + "{}"}
+			}
+			return &printNode{text: name + "{...}"}
+		}
+		n := &printNode{text: name + "{", composite: true, close: "}"}
 		for i := 0; i < v.NumField(); i++ {
 			field := t.Field(i)
 			if !field.IsExported() {
 				continue
 			}
-			fieldValue := v.Field(i)
-			parts = append(parts, fmt.Sprintf("%s: %s", field.Name, formatValueComparison(fieldValue)))
+			p.add(n, field.Name+": ", p.node(v.Field(i), path+"."+field.Name, depth+1))
 		}
-		return fmt.Sprintf("{%s}", strings.Join(parts, ", "))
+		return n
 
 	case reflect.String:
-		return fmt.Sprintf(`"%s"`, v.String())
+		return &printNode{text: p.quote(v.String())}
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
 		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
 		reflect.Uintptr, reflect.Float32, reflect.Float64,
 		reflect.Bool:
-		return fmt.Sprint(v.Interface())
+		return &printNode{text: fmt.Sprint(v.Interface())}
+
+	case reflect.Func, reflect.Chan:
+		return &printNode{text: describeIdentity(v)}
 
 	case reflect.Ptr:
 		if v.IsNil() {
-			return "nil"
+			return &printNode{text: "nil"}
+		}
+		n := p.node(v.Elem(), path, depth)
+		if v.Elem().Kind() == reflect.Struct {
+			n.text = "&" + n.text
 		}
-		return formatValueComparison(v.Elem())
+		return n
 
-	case reflect.Slice, reflect.Array:
+	case reflect.Interface:
 		if v.IsNil() {
-			return "nil"
+			return &printNode{text: "nil"}
 		}
-		var elements []string
+		return p.node(v.Elem(), path, depth)
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return &printNode{text: "nil"}
+		}
+		if elided && v.Len() > 0 {
+			return &printNode{text: fmt.Sprintf("%s(%d items)", v.Type(), v.Len())}
+		}
+		n := &printNode{text: "[", composite: true, close: "]"}
 		for i := 0; i < v.Len(); i++ {
-			elements = append(elements, formatValueComparison(v.Index(i)))
+			if p.maxElements > 0 && i == p.maxElements {
+				p.add(n, "", &printNode{text: fmt.Sprintf("... (%d more)", v.Len()-i)})
+				break
+			}
+			p.add(n, "", p.node(v.Index(i), fmt.Sprintf("%s[%d]", path, i), depth+1))
 		}
-		return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
+		return n
 
 	case reflect.Map:
 		if v.IsNil() {
-			return "nil"
+			return &printNode{text: "nil"}
 		}
-		var pairs []string
-		for _, key := range v.MapKeys() {
-			value := v.MapIndex(key)
-			pairs = append(pairs, fmt.Sprintf("%s: %s", formatValueComparison(key), formatValueComparison(value)))
+		if elided && v.Len() > 0 {
+			return &printNode{text: fmt.Sprintf("%s(%d items)", v.Type(), v.Len())}
 		}
-		return fmt.Sprintf("map[%s]", strings.Join(pairs, ", "))
+		n := &printNode{text: "map[", composite: true, close: "]"}
+		for i, key := range sortedMapKeys(v) {
+			if p.maxElements > 0 && i == p.maxElements {
+				p.add(n, "", &printNode{text: fmt.Sprintf("... (%d more)", v.Len()-i)})
+				break
+			}
+			keyText := p.node(key, path, depth+1).flat()
+			p.add(n, keyText+": ", p.node(v.MapIndex(key), fmt.Sprintf("%s[%s]", path, keyText), depth+1))
+		}
+		return n
 
 	default:
 		if v.CanInterface() {
-			return fmt.Sprint(v.Interface())
+			return &printNode{text: fmt.Sprint(v.Interface())}
 		}
-		return fmt.Sprint(v)
+		return &printNode{text: fmt.Sprint(v)}
+	}
+}
+
+// add appends an item to a composite value, or "..." once the items are longer than
+// maxLength.
+func (p *printer) add(n *printNode, label string, item *printNode) {
+	if n.truncated {
+		return
+	}
+	length := utf8.RuneCountInString(label + item.flat())
+	if p.maxLength > 0 && len(n.items) > 0 && n.length+length > p.maxLength {
+		n.items = append(n.items, printItem{node: &printNode{text: "..."}})
+		n.truncated = true
+		return
+	}
+	n.items = append(n.items, printItem{label: label, node: item})
+	n.length += length
+}
+
+// quote quotes a string, cut to maxString runes.
+func (p *printer) quote(s string) string {
+	if runes := []rune(s); p.maxString > 3 && len(runes) > p.maxString {
+		s = string(runes[:p.maxString-3]) + "..."
+	}
+	return strconv.Quote(s)
+}
+
+// flat returns the printed value on a single line.
+func (n *printNode) flat() string {
+	if !n.composite {
+		return n.text
+	}
+	parts := make([]string, len(n.items))
+	for i, item := range n.items {
+		parts[i] = item.label + item.node.flat()
 	}
+	return n.text + strings.Join(parts, ", ") + n.close
+}
+
+// layout returns the printed value, indented on several lines when it does not fit
+// in maxWidth after the column it starts at. Its items are then printed one per
+// line, below the opening, one level deeper than indent.
+func (p *printer) layout(n *printNode, indent string, column int) string {
+	flat := n.flat()
+	if p.maxWidth == 0 || len(n.items) == 0 || column+utf8.RuneCountInString(flat) <= p.maxWidth {
+		return flat
+	}
+
+	var builder strings.Builder
+	builder.WriteString(n.text + "\n")
+	inner := indent + "  "
+	for _, item := range n.items {
+		builder.WriteString(inner + item.label)
+		builder.WriteString(p.layout(item.node, inner, utf8.RuneCountInString(inner+item.label)))
+		builder.WriteString(",\n")
+	}
+	builder.WriteString(indent + n.close)
+	return builder.String()
+}
+
+// formatFieldDiffs renders differences as the "Field differences" list shown by BeEqual,
+// one "└─ path: expected ≠ actual" line per difference, or its message when it has one.
+func formatFieldDiffs(diffs []fieldDiff) string {
+	var builder strings.Builder
+	builder.WriteString("Field differences:\n")
+	for _, diff := range diffs {
+		if diff.Message != "" {
+			builder.WriteString(fmt.Sprintf("  └─ %s: %s\n", diff.Path, diff.Message))
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("  └─ %s: %s ≠ %s\n", diff.Path, formatDiffValue(diff.Expected), formatDiffValue(diff.Actual)))
+	}
+	return builder.String()
+}
+
+// scrubString applies the scrubbers of cfg to s, in the order they were given.
+func scrubString(cfg *Config, s string) string {
+	for _, scrub := range cfg.Scrubbers {
+		s = scrub(s)
+	}
+	return s
+}
+
+// scrubValue applies the scrubbers of cfg to a string value, keeping its type. Other
+// values are returned as they are.
+func scrubValue(cfg *Config, value any) any {
+	v := reflect.ValueOf(value)
+	if len(cfg.Scrubbers) == 0 || !v.IsValid() || v.Kind() != reflect.String {
+		return value
+	}
+	return reflect.ValueOf(scrubString(cfg, v.String())).Convert(v.Type()).Interface()
+}
+
+// methodString returns the Error or String method result of v, as fmt would print it,
+// so that errors and values such as time.Time are not formatted as their fields.
+func methodString(v reflect.Value) (string, bool) {
+	if !v.CanInterface() {
+		return "", false
+	}
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return "", false
+	}
+	switch value := v.Interface().(type) {
+	case error:
+		return value.Error(), true
+	case fmt.Stringer:
+		return value.String(), true
+	}
+	return "", false
 }
 
 // formatDiffValue formats a value specifically for showing differences.
@@ -148,9 +455,101 @@ func findDifferences(expected, actual interface{}) []fieldDiff {
 	return compareExpectedActual(expected, actual, "")
 }
 
+// findDifferencesWithConfig is like findDifferences, but honours the comparison
+// options in cfg, such as ignored field paths.
+func findDifferencesWithConfig(expected, actual interface{}, cfg *Config) []fieldDiff {
+	d := &differ{cfg: cfg}
+	return d.compare(expected, actual, "")
+}
+
+// relaxesEquality reports whether values that reflect.DeepEqual finds different may
+// still be equal, because cfg ignores some of their differences or because an Equal
+// method or a comparer applies to a value they hold. Only then does the difference
+// report decide equality; otherwise it only explains the failure.
+func relaxesEquality(cfg *Config, expected, actual interface{}) bool {
+	if len(cfg.IgnoreFields) > 0 || cfg.IgnoreOrder || len(cfg.IgnoreOrderPaths) > 0 {
+		return true
+	}
+	seen := make(map[reference]bool)
+	return hasCustomEquality(cfg, reflect.ValueOf(expected), seen) ||
+		hasCustomEquality(cfg, reflect.ValueOf(actual), seen)
+}
+
+// hasCustomEquality reports whether v, or any value held by v, has a type that the
+// differ compares with a comparer or an Equal method rather than field by field.
+func hasCustomEquality(cfg *Config, v reflect.Value, seen map[reference]bool) bool {
+	if !v.IsValid() {
+		return false
+	}
+	t := v.Type()
+	if _, found := cfg.Comparers[t]; found {
+		return true
+	}
+	if method, found := t.MethodByName("Equal"); found && isEqualMethod(method.Type, t) {
+		return true
+	}
+	if ref, ok := referenceOf(v); ok {
+		if seen[ref] {
+			return false
+		}
+		seen[ref] = true
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return !v.IsNil() && hasCustomEquality(cfg, v.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if hasCustomEquality(cfg, v.Field(i), seen) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		// Elements of a basic type without methods or comparer can be skipped all at once
+		if _, found := cfg.Comparers[t.Elem()]; !found && isPrimitive(t.Elem().Kind()) && t.Elem().NumMethod() == 0 {
+			return false
+		}
+		for i := 0; i < v.Len(); i++ {
+			if hasCustomEquality(cfg, v.Index(i), seen) {
+				return true
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if hasCustomEquality(cfg, iter.Value(), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // compareExpectedActual compares two values recursively and records any differences in the provided diffs slice.
 // It handles complex structures like structs, maps, slices, and arrays.
 func compareExpectedActual(expected, actual interface{}, path string) (diffs []fieldDiff) {
+	d := &differ{cfg: &Config{}}
+	return d.compare(expected, actual, path)
+}
+
+// differ walks two values and collects their differences according to the assertion config.
+type differ struct {
+	cfg *Config
+	// visiting holds the pairs of references being compared, to stop at cycles.
+	visiting map[visit]bool
+}
+
+// visit is a pair of references compared by the differ.
+type visit struct {
+	expected, actual reference
+}
+
+// compare compares two values recursively and returns the differences found below path.
+func (d *differ) compare(expected, actual interface{}, path string) (diffs []fieldDiff) {
+	if d.isIgnored(path) {
+		return nil
+	}
+
 	expectedValue := reflect.ValueOf(expected)
 	actualValue := reflect.ValueOf(actual)
 
@@ -174,28 +573,76 @@ func compareExpectedActual(expected, actual interface{}, path string) (diffs []f
 		return
 	}
 
-	switch expectedValue.Kind() {
-	case reflect.Struct:
-		if expectedValue.Type() != actualValue.Type() {
+	if expectedValue.Type() != actualValue.Type() {
+		diffs = append(diffs, fieldDiff{
+			Path:     path,
+			Expected: expectedValue.Type(),
+			Actual:   actualValue.Type(),
+		})
+		return
+	}
+
+	if equal, ok := d.customEqual(expectedValue, actualValue); ok {
+		if !equal {
 			diffs = append(diffs, fieldDiff{
 				Path:     path,
-				Expected: expectedValue.Type(),
-				Actual:   actualValue.Type(),
+				Expected: expected,
+				Actual:   actual,
 			})
-			return
 		}
+		return
+	}
+
+	// A pair already being compared further up is part of a cycle: like
+	// reflect.DeepEqual, assume it is equal rather than comparing it again.
+	expectedRef, expectedOK := referenceOf(expectedValue)
+	actualRef, actualOK := referenceOf(actualValue)
+	if expectedOK && actualOK {
+		pair := visit{expected: expectedRef, actual: actualRef}
+		if d.visiting[pair] {
+			return nil
+		}
+		if d.visiting == nil {
+			d.visiting = make(map[visit]bool)
+		}
+		d.visiting[pair] = true
+		defer delete(d.visiting, pair)
+	}
+
+	switch expectedValue.Kind() {
+	case reflect.Struct:
+		expectedValue = addressable(expectedValue)
+		actualValue = addressable(actualValue)
+
+		var hidden []string
 		typeOfT := expectedValue.Type()
 		for i := 0; i < expectedValue.NumField(); i++ {
 			field := typeOfT.Field(i)
-			if !field.IsExported() {
+			newPath := buildPath(path, field.Name)
+
+			expectedField := readableField(expectedValue, i).Interface()
+			actualField := readableField(actualValue, i).Interface()
+
+			if !field.IsExported() && !d.cfg.UnexportedFields {
+				if len(d.compareHeld(field.Type, expectedField, actualField, newPath)) > 0 {
+					hidden = append(hidden, field.Name)
+				}
 				continue
 			}
-			newPath := buildPath(path, field.Name)
 
-			expectedField := expectedValue.Field(i).Interface()
-			actualField := actualValue.Field(i).Interface()
+			diffs = append(diffs, d.compareHeld(field.Type, expectedField, actualField, newPath)...)
+		}
 
-			diffs = append(diffs, compareExpectedActual(expectedField, actualField, newPath)...)
+		if len(diffs) == 0 && len(hidden) > 0 {
+			diffs = append(diffs, fieldDiff{
+				Path:     path,
+				Expected: expected,
+				Actual:   actual,
+				Message: fmt.Sprintf(
+					"only unexported fields differ: %s (use the WithUnexportedFields() option to compare them)",
+					strings.Join(hidden, ", "),
+				),
+			})
 		}
 
 	case reflect.String:
@@ -243,6 +690,40 @@ func compareExpectedActual(expected, actual interface{}, path string) (diffs []f
 			})
 		}
 
+	case reflect.Complex64, reflect.Complex128:
+		if expectedValue.Complex() != actualValue.Complex() {
+			diffs = append(diffs, fieldDiff{
+				Path:     path,
+				Expected: expectedValue.Interface(),
+				Actual:   actualValue.Interface(),
+			})
+		}
+
+	case reflect.UnsafePointer:
+		if expectedValue.Pointer() != actualValue.Pointer() {
+			diffs = append(diffs, fieldDiff{
+				Path:     path,
+				Expected: expectedValue.Interface(),
+				Actual:   actualValue.Interface(),
+			})
+		}
+
+	case reflect.Func, reflect.Chan:
+		// Like reflect.DeepEqual, funcs are equal only when both are nil and
+		// channels only when they are the same channel
+		equal := expectedValue.IsNil() && actualValue.IsNil()
+		if expectedValue.Kind() == reflect.Chan {
+			equal = expectedValue.Pointer() == actualValue.Pointer()
+		}
+		if !equal {
+			diffs = append(diffs, fieldDiff{
+				Path:     path,
+				Expected: expected,
+				Actual:   actual,
+				Message:  describeIdentityChange(expectedValue, actualValue),
+			})
+		}
+
 	case reflect.Ptr:
 		if expectedValue.IsNil() != actualValue.IsNil() {
 			diffs = append(diffs, fieldDiff{
@@ -253,11 +734,11 @@ func compareExpectedActual(expected, actual interface{}, path string) (diffs []f
 			return
 		}
 		if !expectedValue.IsNil() {
-			diffs = append(diffs, compareExpectedActual(expectedValue.Elem().Interface(), actualValue.Elem().Interface(), path)...)
+			diffs = append(diffs, d.compare(expectedValue.Elem().Interface(), actualValue.Elem().Interface(), path)...)
 		}
 
 	case reflect.Slice, reflect.Array:
-		if expectedValue.IsNil() != actualValue.IsNil() {
+		if expectedValue.Kind() == reflect.Slice && expectedValue.IsNil() != actualValue.IsNil() {
 			diffs = append(diffs, fieldDiff{
 				Path:     path,
 				Expected: expectedValue.Interface(),
@@ -270,32 +751,26 @@ func compareExpectedActual(expected, actual interface{}, path string) (diffs []f
 			return
 		}
 
-		if expectedValue.Len() != actualValue.Len() {
-			diffs = append(diffs, fieldDiff{
-				Path:     path,
-				Expected: nil,
-				Actual:   nil,
-				Message: fmt.Sprintf("length mismatch (expected: %d, actual: %d)",
-					expectedValue.Len(), actualValue.Len()),
-			})
+		if expectedBytes, ok := byteSequence(expected); ok {
+			actualBytes, _ := byteSequence(actual)
+			if offset := firstByteDifference(expectedBytes, actualBytes); offset >= 0 {
+				diffs = append(diffs, fieldDiff{
+					Path:     path,
+					Expected: expected,
+					Actual:   actual,
+					Message: fmt.Sprintf("bytes differ at offset %d (expected %d bytes, actual %d bytes)",
+						offset, len(expectedBytes), len(actualBytes)),
+				})
+			}
 			return
 		}
 
-		//compare elements one by one
-		for i := 0; i < expectedValue.Len(); i++ {
-			if !reflect.DeepEqual(expectedValue.Index(i).Interface(), actualValue.Index(i).Interface()) {
-				elementPath := buildPath(path, fmt.Sprintf("[%d]", i))
-				diffs = append(
-					diffs,
-					compareExpectedActual(
-						expectedValue.Index(i).Interface(),
-						actualValue.Index(i).Interface(),
-						elementPath,
-					)...,
-				)
-			}
+		if d.ignoresOrder(path) {
+			return d.compareUnordered(expectedValue, actualValue, path)
 		}
 
+		return d.compareSequences(expectedValue, actualValue, path)
+
 	case reflect.Map:
 		if expectedValue.IsNil() != actualValue.IsNil() {
 			diffs = append(diffs, fieldDiff{
@@ -310,10 +785,13 @@ func compareExpectedActual(expected, actual interface{}, path string) (diffs []f
 			return
 		}
 
-		for _, key := range expectedValue.MapKeys() {
+		for _, key := range sortedMapKeys(expectedValue) {
 			actualVal := actualValue.MapIndex(key)
 			keyStr := fmt.Sprint(key.Interface())
 			keyPath := buildPath(path, fmt.Sprintf("[%s]", keyStr))
+			if d.isIgnored(keyPath) {
+				continue
+			}
 
 			if !actualVal.IsValid() {
 				diffs = append(diffs, fieldDiff{
@@ -325,7 +803,8 @@ func compareExpectedActual(expected, actual interface{}, path string) (diffs []f
 			}
 
 			if !reflect.DeepEqual(expectedValue.MapIndex(key).Interface(), actualVal.Interface()) {
-				diffs = append(diffs, compareExpectedActual(
+				diffs = append(diffs, d.compareHeld(
+					expectedValue.Type().Elem(),
 					expectedValue.MapIndex(key).Interface(),
 					actualVal.Interface(),
 					keyPath,
@@ -333,11 +812,14 @@ func compareExpectedActual(expected, actual interface{}, path string) (diffs []f
 			}
 		}
 
-		for _, key := range actualValue.MapKeys() {
+		for _, key := range sortedMapKeys(actualValue) {
 			expectedVal := expectedValue.MapIndex(key)
 			if !expectedVal.IsValid() {
 				keyStr := fmt.Sprint(key.Interface())
 				keyPath := buildPath(path, fmt.Sprintf("[%s]", keyStr))
+				if d.isIgnored(keyPath) {
+					continue
+				}
 
 				diffs = append(diffs, fieldDiff{
 					Path:     keyPath,
@@ -346,60 +828,926 @@ func compareExpectedActual(expected, actual interface{}, path string) (diffs []f
 				})
 			}
 		}
+
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			diffs = append(diffs, fieldDiff{
+				Path:     path,
+				Expected: expected,
+				Actual:   actual,
+			})
+		}
+	}
+	return
+}
+
+// compareHeld compares two values read from a struct field, element or map value of
+// type t. When t is an interface type, such as any, the values may have different
+// concrete types: the change of type is then reported on its own, along with both
+// values, rather than as a bare kind or type difference.
+func (d *differ) compareHeld(t reflect.Type, expected, actual interface{}, path string) []fieldDiff {
+	expectedValue := reflect.ValueOf(expected)
+	actualValue := reflect.ValueOf(actual)
+	if t.Kind() != reflect.Interface || !expectedValue.IsValid() || !actualValue.IsValid() ||
+		expectedValue.Type() == actualValue.Type() || d.isIgnored(path) {
+		return d.compare(expected, actual, path)
+	}
+	return []fieldDiff{{
+		Path:     path,
+		Expected: expectedValue.Type(),
+		Actual:   actualValue.Type(),
+		Message:  describeTypeChange(expectedValue, actualValue),
+	}}
+}
+
+// describeTypeChange explains a difference between two values of different concrete
+// types, as in "type int ≠ string (1 ≠ "1")".
+func describeTypeChange(expected, actual reflect.Value) string {
+	return fmt.Sprintf("type %s ≠ %s (%s ≠ %s)",
+		expected.Type(), actual.Type(),
+		formatDiffValue(expected.Interface()), formatDiffValue(actual.Interface()))
+}
+
+// describeIdentityChange explains a difference between two funcs or two channels,
+// which have no value to compare but only their identity.
+func describeIdentityChange(expected, actual reflect.Value) string {
+	message := describeIdentity(expected) + " ≠ " + describeIdentity(actual)
+	switch {
+	case expected.IsNil() || actual.IsNil():
+		return message
+	case expected.Kind() == reflect.Func:
+		return message + " (funcs are only equal when both are nil)"
+	default:
+		return message + " (channels are only equal when they are the same channel)"
+	}
+}
+
+// describeIdentity describes a func by its name, such as "func main.handle", and a
+// channel by its type and address.
+func describeIdentity(v reflect.Value) string {
+	if v.IsNil() {
+		return "nil"
+	}
+	if v.Kind() == reflect.Func {
+		fn := runtime.FuncForPC(v.Pointer())
+		if fn == nil {
+			return "func"
+		}
+		return "func " + fn.Name()[strings.LastIndex(fn.Name(), "/")+1:]
+	}
+	return fmt.Sprintf("%s %#x", v.Type(), v.Pointer())
+}
+
+// customEqual compares two values of the same type with the comparer registered for
+// that type through WithComparer, or else with the type's own Equal method.
+// The second result is false when neither is available.
+func (d *differ) customEqual(expected, actual reflect.Value) (equal, ok bool) {
+	if comparer, found := d.cfg.Comparers[expected.Type()]; found {
+		return comparer(expected.Interface(), actual.Interface()), true
+	}
+
+	method, found := expected.Type().MethodByName("Equal")
+	if !found || !isEqualMethod(method.Type, expected.Type()) {
+		return false, false
+	}
+	if expected.Kind() == reflect.Ptr && (expected.IsNil() || actual.IsNil()) {
+		return false, false
+	}
+	return method.Func.Call([]reflect.Value{expected, actual})[0].Bool(), true
+}
+
+// isEqualMethod reports whether method, including its receiver, has the signature
+// func(T, T) bool for the given type T.
+func isEqualMethod(method reflect.Type, t reflect.Type) bool {
+	return method.NumIn() == 2 && method.In(1) == t &&
+		method.NumOut() == 1 && method.Out(0).Kind() == reflect.Bool
+}
+
+// addressable returns v when it is addressable, or an addressable copy of it otherwise.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// readableField returns the i-th field of the addressable struct v. Unexported fields
+// are accessed through their address, so that their values can be read.
+func readableField(v reflect.Value, i int) reflect.Value {
+	field := v.Field(i)
+	if field.CanInterface() {
+		return field
+	}
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
+
+// buildPath creates a dotted path for nested fields to provide clear identification
+// of where differences occur in complex structures.
+func buildPath(parent, field string) string {
+	if parent == "" {
+		return field
+	}
+	return parent + "." + field
+}
+
+// isIgnored reports whether the value at path was excluded from the comparison
+// with WithIgnoreFields.
+func (d *differ) isIgnored(path string) bool {
+	if path == "" {
+		return false
+	}
+	for _, pattern := range d.cfg.IgnoreFields {
+		if matchPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxSequenceDiffCells bounds the size of the table used to align two sequences, which
+// grows with the product of their lengths once the common prefix and suffix are removed.
+// Longer sequences are compared position by position instead.
+const maxSequenceDiffCells = 250_000
+
+// alignSequences pairs up the equal elements of two sequences of lengths n and m along
+// their longest common subsequence. The returned index pairs are in increasing order and
+// framed by {-1, -1} and {n, m}, so that the elements between two consecutive pairs are
+// the ones removed from the first sequence or inserted in the second.
+func alignSequences(n, m int, equal func(i, j int) bool) [][2]int {
+	matches := [][2]int{{-1, -1}}
+	prefix := 0
+	for prefix < n && prefix < m && equal(prefix, prefix) {
+		matches = append(matches, [2]int{prefix, prefix})
+		prefix++
+	}
+	suffix := 0
+	for suffix < n-prefix && suffix < m-prefix && equal(n-1-suffix, m-1-suffix) {
+		suffix++
+	}
+
+	rows, cols := n-prefix-suffix, m-prefix-suffix
+	if rows > 0 && cols > 0 && rows*cols <= maxSequenceDiffCells {
+		same := make([]bool, rows*cols)
+		lengths := make([]int, (rows+1)*(cols+1))
+		for i := rows - 1; i >= 0; i-- {
+			for j := cols - 1; j >= 0; j-- {
+				if equal(prefix+i, prefix+j) {
+					same[i*cols+j] = true
+					lengths[i*(cols+1)+j] = lengths[(i+1)*(cols+1)+j+1] + 1
+				} else {
+					lengths[i*(cols+1)+j] = max(lengths[(i+1)*(cols+1)+j], lengths[i*(cols+1)+j+1])
+				}
+			}
+		}
+		for i, j := 0, 0; i < rows && j < cols; {
+			switch {
+			case same[i*cols+j]:
+				matches = append(matches, [2]int{prefix + i, prefix + j})
+				i++
+				j++
+			case lengths[(i+1)*(cols+1)+j] >= lengths[i*(cols+1)+j+1]:
+				i++
+			default:
+				j++
+			}
+		}
+	}
+
+	for k := suffix; k > 0; k-- {
+		matches = append(matches, [2]int{n - k, m - k})
+	}
+	return append(matches, [2]int{n, m})
+}
+
+// compareSequences aligns two slices or arrays on their equal elements, using their
+// longest common subsequence, and reports the elements that were removed from expected
+// ("- [i] value") or inserted in actual ("+ [j] value"). Between two aligned elements,
+// removed and inserted elements are paired up in order and compared recursively, so a
+// changed element is reported field by field while an inserted one does not shift the rest.
+func (d *differ) compareSequences(expected, actual reflect.Value, path string) (diffs []fieldDiff) {
+	elementPath := func(i int) string {
+		return buildPath(path, fmt.Sprintf("[%d]", i))
+	}
+	equal := func(i, j int) bool {
+		expectedElement := expected.Index(i).Interface()
+		actualElement := actual.Index(j).Interface()
+		return reflect.DeepEqual(expectedElement, actualElement) ||
+			len(d.compare(expectedElement, actualElement, elementPath(i))) == 0
+	}
+
+	matches := alignSequences(expected.Len(), actual.Len(), equal)
+	for k := 1; k < len(matches); k++ {
+		fromExpected, toExpected := matches[k-1][0]+1, matches[k][0]
+		fromActual, toActual := matches[k-1][1]+1, matches[k][1]
+
+		paired := min(toExpected-fromExpected, toActual-fromActual)
+		for p := 0; p < paired; p++ {
+			diffs = append(diffs, d.compareHeld(
+				expected.Type().Elem(),
+				expected.Index(fromExpected+p).Interface(),
+				actual.Index(fromActual+p).Interface(),
+				elementPath(fromExpected+p),
+			)...)
+		}
+		for i := fromExpected + paired; i < toExpected; i++ {
+			element := expected.Index(i).Interface()
+			diffs = append(diffs, fieldDiff{
+				Path:     path,
+				Expected: element,
+				Message:  fmt.Sprintf("- [%d] %s", i, formatDiffValue(element)),
+			})
+		}
+		for j := fromActual + paired; j < toActual; j++ {
+			element := actual.Index(j).Interface()
+			diffs = append(diffs, fieldDiff{
+				Path:    path,
+				Actual:  element,
+				Message: fmt.Sprintf("+ [%d] %s", j, formatDiffValue(element)),
+			})
+		}
+	}
+	return diffs
+}
+
+// ignoresOrder reports whether the slice at path is compared regardless of element order.
+func (d *differ) ignoresOrder(path string) bool {
+	if d.cfg.IgnoreOrder {
+		return true
+	}
+	for _, pattern := range d.cfg.IgnoreOrderPaths {
+		if matchPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareUnordered compares two slices or arrays as multisets. Each expected element is
+// paired with the first equal actual element that is not paired yet; the elements left
+// over are reported as missing from actual or as unexpected in it.
+func (d *differ) compareUnordered(expected, actual reflect.Value, path string) (diffs []fieldDiff) {
+	paired := make([]bool, actual.Len())
+	for i := 0; i < expected.Len(); i++ {
+		elementPath := buildPath(path, fmt.Sprintf("[%d]", i))
+		expectedElement := expected.Index(i).Interface()
+
+		found := false
+		for j := 0; j < actual.Len() && !found; j++ {
+			if !paired[j] && len(d.compare(expectedElement, actual.Index(j).Interface(), elementPath)) == 0 {
+				paired[j] = true
+				found = true
+			}
+		}
+		if !found {
+			diffs = append(diffs, fieldDiff{
+				Path:     elementPath,
+				Expected: expectedElement,
+				Message:  "missing element " + formatDiffValue(expectedElement),
+			})
+		}
+	}
+
+	for j, ok := range paired {
+		if ok {
+			continue
+		}
+		actualElement := actual.Index(j).Interface()
+		diffs = append(diffs, fieldDiff{
+			Path:    buildPath(path, fmt.Sprintf("[%d]", j)),
+			Actual:  actualElement,
+			Message: "unexpected element " + formatDiffValue(actualElement),
+		})
+	}
+	return diffs
+}
+
+// matchPath reports whether a path built by buildPath matches pattern.
+//
+// Patterns use the same dotted syntax as buildPath, with index and key segments
+// optionally written without the leading dot ("Items[0]" is the same as "Items.[0]").
+// The segment "[*]" matches any index or map key, and "*" matches any segment.
+func matchPath(pattern, path string) bool {
+	patternParts := splitPath(normalizePath(pattern))
+	pathParts := splitPath(path)
+	if len(patternParts) != len(pathParts) {
+		return false
+	}
+
+	for i, part := range patternParts {
+		switch {
+		case part == "*" || part == pathParts[i]:
+			continue
+		case part == "[*]" && strings.HasPrefix(pathParts[i], "[") && strings.HasSuffix(pathParts[i], "]"):
+			continue
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// normalizePath rewrites "Items[0]" style segments to the "Items.[0]" form used by buildPath.
+func normalizePath(path string) string {
+	var b strings.Builder
+	depth := 0
+	for i, r := range path {
+		switch r {
+		case '[':
+			if depth == 0 && i > 0 && path[i-1] != '.' {
+				b.WriteByte('.')
+			}
+			depth++
+		case ']':
+			depth--
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// splitPath splits a path into its segments, keeping dots inside map keys intact.
+func splitPath(path string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range path {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				parts = append(parts, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, path[start:])
+}
+
+// formatMultilineString formats long strings into a readable multi-line layout
+// for use in error messages. Strings shorter than 280 characters are returned as-is.
+// Otherwise, it shows up to 5 initial lines (56 chars each), and if longer,
+// appends the last 3 lines for context.
+func formatMultilineString(s string) string {
+	if len(s) < 280 {
+		return s
+	}
+
+	builder := strings.Builder{}
+
+	totalLine := len(s) / 56
+
+	builder.WriteString("Length: ")
+	builder.WriteString(fmt.Sprintf("%d", len(s)))
+	builder.WriteString(" characters, ")
+	builder.WriteString(fmt.Sprintf("%d lines", totalLine))
+	builder.WriteString("\n")
+
+	// max 5 lines and 56 characters per line
+	for i := range 5 {
+		builder.WriteString(fmt.Sprintf("%d. ", i+1))
+		builder.WriteString(s[i*56 : min(i*56+56, len(s))])
+		builder.WriteString("\n")
+	}
+
+	if totalLine > 5 {
+		builder.WriteString("\n")
+		builder.WriteString("Last lines:\n")
+
+		// print last 3 lines
+		for i := totalLine - 3; i < totalLine; i++ {
+			builder.WriteString(fmt.Sprintf("%d. ", i+1))
+			builder.WriteString(s[i*56 : min(i*56+56, len(s))])
+			builder.WriteString("\n")
+		}
+	}
+
+	return builder.String()
+}
+
+//  === THIS SECTION IS TO DIFF LONG STRINGS LINE BY LINE ===
+
+// lineDiffContext is the number of unchanged lines shown around each change in a line diff.
+const lineDiffContext = 3
+
+// needsLineDiff reports whether two strings are easier to compare line by line, because
+// one of them spans several lines or is too long to read at once.
+func needsLineDiff(expected, actual string) bool {
+	return strings.Contains(expected, "\n") || strings.Contains(actual, "\n") ||
+		len(expected) >= 280 || len(actual) >= 280
+}
+
+// lineEdit is a single line of a line diff: kept (' '), removed ('-') or inserted ('+').
+// expectedLine and actualLine are the 0-based positions of the line in both strings.
+type lineEdit struct {
+	op           byte
+	text         string
+	expectedLine int
+	actualLine   int
+}
+
+// formatLineDiff returns a unified diff of two strings, from expected to actual, with
+// hunk headers and lineDiffContext unchanged lines around each change.
+//
+// Example output:
+//
+//	--- expected
+//	+++ actual
+//	@@ -1,3 +1,3 @@
+//	 SELECT id, name
+//	-FROM users
+//	+FROM accounts
+//	 WHERE active = true
+func formatLineDiff(expected, actual string) string {
+	expectedLines := strings.Split(expected, "\n")
+	actualLines := strings.Split(actual, "\n")
+	matches := alignSequences(len(expectedLines), len(actualLines), func(i, j int) bool {
+		return expectedLines[i] == actualLines[j]
+	})
+
+	var edits []lineEdit
+	var changes []int
+	for k := 1; k < len(matches); k++ {
+		prev, next := matches[k-1], matches[k]
+		for i := prev[0] + 1; i < next[0]; i++ {
+			changes = append(changes, len(edits))
+			edits = append(edits, lineEdit{op: '-', text: expectedLines[i], expectedLine: i, actualLine: prev[1] + 1})
+		}
+		for j := prev[1] + 1; j < next[1]; j++ {
+			changes = append(changes, len(edits))
+			edits = append(edits, lineEdit{op: '+', text: actualLines[j], expectedLine: next[0], actualLine: j})
+		}
+		if next[0] < len(expectedLines) {
+			edits = append(edits, lineEdit{op: ' ', text: expectedLines[next[0]], expectedLine: next[0], actualLine: next[1]})
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString("--- expected\n+++ actual")
+	for c := 0; c < len(changes); {
+		start := max(0, changes[c]-lineDiffContext)
+		end := changes[c] + 1
+		for c++; c < len(changes) && changes[c]-end <= 2*lineDiffContext; c++ {
+			end = changes[c] + 1
+		}
+		end = min(len(edits), end+lineDiffContext)
+		writeHunk(&builder, edits[start:end])
+	}
+	return builder.String()
+}
+
+// writeHunk writes one hunk of a line diff, preceded by its "@@ -a,b +c,d @@" header.
+func writeHunk(builder *strings.Builder, edits []lineEdit) {
+	expectedCount, actualCount := 0, 0
+	for _, edit := range edits {
+		if edit.op != '+' {
+			expectedCount++
+		}
+		if edit.op != '-' {
+			actualCount++
+		}
+	}
+
+	// Empty ranges start at the line before them, as in diff -u.
+	expectedStart, actualStart := edits[0].expectedLine, edits[0].actualLine
+	if expectedCount > 0 {
+		expectedStart++
+	}
+	if actualCount > 0 {
+		actualStart++
+	}
+
+	fmt.Fprintf(builder, "\n@@ -%d,%d +%d,%d @@", expectedStart, expectedCount, actualStart, actualCount)
+	for _, edit := range edits {
+		builder.WriteString("\n")
+		builder.WriteByte(edit.op)
+		builder.WriteString(edit.text)
+	}
+}
+
+//  === THIS SECTION IS TO DIFF SHORT STRINGS CHARACTER BY CHARACTER ===
+
+// charDiffContext is the number of runes shown on each side of the first difference
+// between two strings that are too long to be shown in full.
+const charDiffContext = 20
+
+// formatCharDiff points at the first rune where two single-line strings differ. The
+// returned lines are meant to follow the "expected:" and "actual  :" lines of a failure:
+// short strings get a caret under the actual value, longer ones are shown again as a
+// window of charDiffContext runes around the difference.
+//
+// Example output, after "expected: hello world" and "actual  : hello wrold":
+//
+//	                 ^
+//	First difference at position 8 (possible typo)
+func formatCharDiff(expected, actual string) string {
+	expectedRunes, actualRunes := []rune(expected), []rune(actual)
+	pos := 0
+	for pos < len(expectedRunes) && pos < len(actualRunes) && expectedRunes[pos] == actualRunes[pos] {
+		pos++
+	}
+
+	const labelWidth = len("actual  : ")
+	var builder strings.Builder
+	column := labelWidth + pos
+	if len(expectedRunes) > 2*charDiffContext || len(actualRunes) > 2*charDiffContext {
+		from := max(0, pos-charDiffContext)
+		expectedWindow, offset := runeWindow(expectedRunes, from, pos)
+		actualWindow, _ := runeWindow(actualRunes, from, pos)
+		builder.WriteString(fmt.Sprintf("\nAround position %d:", pos+1))
+		builder.WriteString("\nexpected: " + expectedWindow)
+		builder.WriteString("\nactual  : " + actualWindow)
+		column = labelWidth + offset
+	}
+	builder.WriteString("\n" + strings.Repeat(" ", column) + "^")
+
+	builder.WriteString(fmt.Sprintf("\nFirst difference at position %d", pos+1))
+	if hint := describeStringChange(expected, actual); hint != "" {
+		builder.WriteString(" (" + hint + ")")
+	}
+	return builder.String()
+}
+
+// runeWindow returns the runes of r from index from up to charDiffContext runes past pos,
+// with "..." marking the truncated ends, and the offset of pos within the window.
+func runeWindow(r []rune, from, pos int) (string, int) {
+	to := min(len(r), pos+charDiffContext)
+	from = min(from, to)
+	window := string(r[from:to])
+	offset := pos - from
+	if from > 0 {
+		window = "..." + window
+		offset += 3
+	}
+	if to < len(r) {
+		window += "..."
+	}
+	return window, offset
+}
+
+// describeStringChange labels how actual differs from expected, reusing the checks
+// of calculateStringSimilarity: a case difference, a missing or extra prefix or suffix,
+// or a likely typo. It returns "" when the strings are not similar enough, and leaves
+// out the typo label when only invisible or look-alike characters differ, which the
+// look-alike note explains instead.
+func describeStringChange(expected, actual string) string {
+	switch calculateStringSimilarity(expected, actual).DiffType {
+	case "case":
+		return "case difference"
+	case "prefix":
+		if len(actual) > len(expected) {
+			return fmt.Sprintf("actual has extra suffix %q", actual[len(expected):])
+		}
+		return fmt.Sprintf("actual is missing suffix %q", expected[len(actual):])
+	case "suffix":
+		if len(actual) > len(expected) {
+			return fmt.Sprintf("actual has extra prefix %q", actual[:len(actual)-len(expected)])
+		}
+		return fmt.Sprintf("actual is missing prefix %q", expected[:len(expected)-len(actual)])
+	case "typo":
+		if lookAlikeSkeleton(expected) == lookAlikeSkeleton(actual) {
+			return ""
+		}
+		return "possible typo"
+	default:
+		return ""
+	}
+}
+
+//  === THIS SECTION IS TO REVEAL INVISIBLE AND LOOK-ALIKE CHARACTERS ===
+
+// maxLookAlikeValueLen is the length up to which a string is repeated, with its invisible
+// characters escaped, in a look-alike note. Longer strings only get their annotations.
+const maxLookAlikeValueLen = 200
+
+// maxLookAlikeAnnotations limits the number of characters annotated for each string.
+const maxLookAlikeAnnotations = 10
+
+// runeNames names the invisible characters that most often sneak into test data.
+var runeNames = map[rune]string{
+	'\t':     "TAB",
+	'\r':     "CARRIAGE RETURN",
+	' ':      "SPACE",
+	'\u00a0': "NO-BREAK SPACE",
+	'\u00ad': "SOFT HYPHEN",
+	'\u2002': "EN SPACE",
+	'\u2003': "EM SPACE",
+	'\u2009': "THIN SPACE",
+	'\u200b': "ZERO WIDTH SPACE",
+	'\u200c': "ZERO WIDTH NON-JOINER",
+	'\u200d': "ZERO WIDTH JOINER",
+	'\u200e': "LEFT-TO-RIGHT MARK",
+	'\u200f': "RIGHT-TO-LEFT MARK",
+	'\u2028': "LINE SEPARATOR",
+	'\u2029': "PARAGRAPH SEPARATOR",
+	'\u202f': "NARROW NO-BREAK SPACE",
+	'\u2060': "WORD JOINER",
+	'\u3000': "IDEOGRAPHIC SPACE",
+	'\ufeff': "ZERO WIDTH NO-BREAK SPACE (BOM)",
+}
+
+// confusables maps Cyrillic and Greek letters to the Latin letters they look like.
+var confusables = map[rune]rune{
+	'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x', 'і': 'i', 'ј': 'j', 'ѕ': 's',
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O', 'Р': 'P', 'С': 'C', 'Т': 'T', 'Х': 'X',
+	'α': 'a', 'ο': 'o', 'ν': 'v', 'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ι': 'I', 'Κ': 'K', 'Ν': 'N', 'Ο': 'O', 'Ρ': 'P',
+	'Τ': 'T', 'Χ': 'X',
+}
+
+// unicodeCategories lists the general categories named in look-alike annotations.
+var unicodeCategories = []struct {
+	name  string
+	table *unicode.RangeTable
+}{
+	{"Cc, control", unicode.Cc},
+	{"Cf, format", unicode.Cf},
+	{"Zs, space separator", unicode.Zs},
+	{"Zl, line separator", unicode.Zl},
+	{"Zp, paragraph separator", unicode.Zp},
+	{"Lu, uppercase letter", unicode.Lu},
+	{"Ll, lowercase letter", unicode.Ll},
+}
+
+// lookAlikeSkeleton returns s as it looks when printed: invisible characters are
+// dropped, every kind of space becomes a plain space, trailing spaces are trimmed
+// from each line and look-alike letters are replaced with their Latin counterpart.
+func lookAlikeSkeleton(s string) string {
+	var builder strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\r' || unicode.Is(unicode.Cf, r):
+			continue
+		case r == '\t' || unicode.Is(unicode.Zs, r):
+			builder.WriteRune(' ')
+		default:
+			if latin, ok := confusables[r]; ok {
+				r = latin
+			}
+			builder.WriteRune(r)
+		}
+	}
+
+	lines := strings.Split(builder.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isLookAlikeRune reports whether the rune at index i of runes is hard to see or easy
+// to mistake for another one: a control, format or unusual space character, a letter
+// that looks like a Latin one, or a space or tab at the end of a line.
+func isLookAlikeRune(runes []rune, i int) bool {
+	r := runes[i]
+	switch {
+	case r == '\n':
+		return false
+	case r == ' ' || r == '\t':
+		return i == len(runes)-1 || runes[i+1] == '\n' || runes[i+1] == '\r'
+	case unicode.IsControl(r) || unicode.In(r, unicode.Cf, unicode.Zs, unicode.Zl, unicode.Zp):
+		return true
+	default:
+		_, ok := confusables[r]
+		return ok
+	}
+}
+
+// escapeLookAlike renders s with spaces shown as "␠", control characters escaped and
+// the other look-alike characters replaced with their code point, such as <U+00A0>.
+func escapeLookAlike(s string) string {
+	runes := []rune(s)
+	var builder strings.Builder
+	for i, r := range runes {
+		switch {
+		case r == ' ':
+			builder.WriteString("␠")
+		case r == '\t':
+			builder.WriteString(`\t`)
+		case r == '\r':
+			builder.WriteString(`\r`)
+		case r == '\n':
+			builder.WriteString(`\n`)
+		case isLookAlikeRune(runes, i):
+			builder.WriteString(fmt.Sprintf("<U+%04X>", r))
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// describeLookAlikeRune names a look-alike character and its Unicode category.
+func describeLookAlikeRune(r rune) string {
+	category := "unassigned"
+	for _, c := range unicodeCategories {
+		if unicode.Is(c.table, r) {
+			category = c.name
+			break
+		}
+	}
+
+	if latin, ok := confusables[r]; ok {
+		script := "Greek"
+		if unicode.Is(unicode.Cyrillic, r) {
+			script = "Cyrillic"
+		}
+		return fmt.Sprintf("U+%04X %s letter %q, looks like Latin %q (%s)", r, script, r, latin, category)
 	}
-	return
+	if name, ok := runeNames[r]; ok {
+		return fmt.Sprintf("U+%04X %s (%s)", r, name, category)
+	}
+	return fmt.Sprintf("U+%04X (%s)", r, category)
 }
 
-// buildPath creates a dotted path for nested fields to provide clear identification
-// of where differences occur in complex structures.
-func buildPath(parent, field string) string {
-	if parent == "" {
-		return field
+// lookAlikeEqualityNote returns the look-alike note for two unequal strings that look
+// the same when printed, or "" otherwise.
+func lookAlikeEqualityNote(expected, actual string) string {
+	if lookAlikeSkeleton(expected) != lookAlikeSkeleton(actual) {
+		return ""
 	}
-	return parent + "." + field
+	return formatLookAlikeNote([]string{"expected", "actual  "}, []string{expected, actual})
 }
 
-// formatMultilineString formats long strings into a readable multi-line layout
-// for use in error messages. Strings shorter than 280 characters are returned as-is.
-// Otherwise, it shows up to 5 initial lines (56 chars each), and if longer,
-// appends the last 3 lines for context.
-func formatMultilineString(s string) string {
-	if len(s) < 280 {
-		return s
+// formatLookAlikeNote explains which invisible or look-alike characters the given
+// strings contain, for failures where the strings look the same but are not. Each
+// string is shown escaped, under its label, followed by one line per annotated character.
+//
+// Example output:
+//
+//	Note: the strings look alike but differ in invisible or look-alike characters:
+//	  expected: hello␠world
+//	  actual  : hello<U+00A0>world
+//	    └─ position 6: U+00A0 NO-BREAK SPACE (Zs, space separator)
+func formatLookAlikeNote(labels, values []string) string {
+	var builder strings.Builder
+	builder.WriteString("\nNote: the strings look alike but differ in invisible or look-alike characters:")
+	for i, value := range values {
+		builder.WriteString(fmt.Sprintf("\n  %s: ", labels[i]))
+		if len(value) > maxLookAlikeValueLen {
+			builder.WriteString(fmt.Sprintf("(length: %d)", len(value)))
+		} else {
+			builder.WriteString(escapeLookAlike(value))
+		}
+
+		runes := []rune(value)
+		annotated := 0
+		for pos := range runes {
+			if !isLookAlikeRune(runes, pos) {
+				continue
+			}
+			if annotated == maxLookAlikeAnnotations {
+				builder.WriteString("\n    └─ ...")
+				break
+			}
+			annotated++
+			description := describeLookAlikeRune(runes[pos])
+			if runes[pos] == ' ' || runes[pos] == '\t' {
+				description = "trailing " + description
+			}
+			builder.WriteString(fmt.Sprintf("\n    └─ position %d: %s", pos+1, description))
+		}
 	}
+	return builder.String()
+}
 
-	builder := strings.Builder{}
+//  === THIS SECTION IS TO DIFF BYTE SEQUENCES AS HEXDUMPS ===
 
-	totalLine := len(s) / 56
+// hexDumpRowWidth is the number of bytes shown on each row of a hexdump diff.
+const hexDumpRowWidth = 8
 
-	builder.WriteString("Length: ")
-	builder.WriteString(fmt.Sprintf("%d", len(s)))
-	builder.WriteString(" characters, ")
-	builder.WriteString(fmt.Sprintf("%d lines", totalLine))
-	builder.WriteString("\n")
+// hexDumpContext is the number of unchanged rows shown around each differing row.
+const hexDumpContext = 2
 
-	// max 5 lines and 56 characters per line
-	for i := range 5 {
-		builder.WriteString(fmt.Sprintf("%d. ", i+1))
-		builder.WriteString(s[i*56 : min(i*56+56, len(s))])
-		builder.WriteString("\n")
+// maxHexDumpRows caps the number of differing rows shown in a hexdump diff.
+const maxHexDumpRows = 16
+
+// byteSequence returns the bytes of a []byte, a [N]byte or a type based on them,
+// such as json.RawMessage. The second result is false for any other value.
+func byteSequence(value any) ([]byte, bool) {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || v.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
 	}
+	b := make([]byte, v.Len())
+	for i := range b {
+		b[i] = byte(v.Index(i).Uint())
+	}
+	return b, true
+}
 
-	if totalLine > 5 {
-		builder.WriteString("\n")
-		builder.WriteString("Last lines:\n")
+// describeEmptySlice describes a slice without elements as "nil slice" or "empty slice".
+func describeEmptySlice(v reflect.Value) string {
+	if v.IsNil() {
+		return "nil slice"
+	}
+	return "empty slice"
+}
 
-		// print last 3 lines
-		for i := totalLine - 3; i < totalLine; i++ {
-			builder.WriteString(fmt.Sprintf("%d. ", i+1))
-			builder.WriteString(s[i*56 : min(i*56+56, len(s))])
-			builder.WriteString("\n")
+// firstByteDifference returns the offset of the first byte that differs between two
+// byte sequences, or -1 when they are equal.
+func firstByteDifference(expected, actual []byte) int {
+	for i := 0; i < len(expected) && i < len(actual); i++ {
+		if expected[i] != actual[i] {
+			return i
 		}
 	}
+	if len(expected) != len(actual) {
+		return min(len(expected), len(actual))
+	}
+	return -1
+}
+
+// formatHexDiff renders two byte sequences side by side as hexdumps, with the offset,
+// the hex bytes and their ASCII form. Rows that differ are marked with "≠" and shown
+// with hexDumpContext rows of context; at most maxHexDumpRows differing rows are shown.
+//
+// Example output:
+//
+//	Bytes differ at offset 11 (expected 16 bytes, actual 17 bytes):
+//	  offset    expected                           actual
+//	  00000000  48 65 6c 6c 6f 2c 20 77  Hello, w  48 65 6c 6c 6f 2c 20 77  Hello, w
+//	≠ 00000008  6f 72 6c 64 21 0a 00 01  orld!...  6f 72 6c 64 21 0a 00 02  orld!...
+//	≠ 00000010                                     ff                       .
+func formatHexDiff(expected, actual []byte) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Bytes differ at offset %d (expected %d bytes, actual %d bytes):",
+		firstByteDifference(expected, actual), len(expected), len(actual)))
+	builder.WriteString(fmt.Sprintf("\n  offset    %-35s%s", "expected", "actual"))
+
+	rows := (max(len(expected), len(actual)) + hexDumpRowWidth - 1) / hexDumpRowWidth
+	rowDiffers := func(row int) bool {
+		from := row * hexDumpRowWidth
+		return !bytes.Equal(byteRow(expected, from), byteRow(actual, from))
+	}
+
+	shown, lastRow := 0, -1
+	for row := 0; row < rows; row++ {
+		if !rowDiffers(row) {
+			continue
+		}
+		if shown == maxHexDumpRows {
+			builder.WriteString("\n  ... (more differences not shown)")
+			return builder.String()
+		}
+		shown++
 
+		from := max(lastRow+1, row-hexDumpContext)
+		if lastRow >= 0 && from > lastRow+1 {
+			builder.WriteString("\n  ...")
+		}
+		to := min(rows-1, row+hexDumpContext)
+		for r := from; r <= to; r++ {
+			if r > row && rowDiffers(r) {
+				break
+			}
+			writeHexRow(&builder, expected, actual, r, rowDiffers(r))
+			lastRow = r
+		}
+	}
 	return builder.String()
 }
 
+// byteRow returns the bytes of a hexdump row starting at offset from.
+func byteRow(b []byte, from int) []byte {
+	if from >= len(b) {
+		return nil
+	}
+	return b[from:min(len(b), from+hexDumpRowWidth)]
+}
+
+// writeHexRow writes one side-by-side row of a hexdump diff.
+func writeHexRow(builder *strings.Builder, expected, actual []byte, row int, differs bool) {
+	marker := "  "
+	if differs {
+		marker = "≠ "
+	}
+	from := row * hexDumpRowWidth
+	line := fmt.Sprintf("%s%08x  %s  %s", marker, from,
+		formatHexRow(byteRow(expected, from)), formatHexRow(byteRow(actual, from)))
+	builder.WriteString("\n" + strings.TrimRight(line, " "))
+}
+
+// formatHexRow formats the bytes of one row as padded hex followed by their ASCII form,
+// where non-printable bytes are shown as ".".
+func formatHexRow(row []byte) string {
+	hex := make([]string, hexDumpRowWidth)
+	ascii := make([]byte, hexDumpRowWidth)
+	for i := range hex {
+		hex[i], ascii[i] = "  ", ' '
+		if i < len(row) {
+			hex[i] = fmt.Sprintf("%02x", row[i])
+			ascii[i] = '.'
+			if row[i] >= 0x20 && row[i] < 0x7f {
+				ascii[i] = row[i]
+			}
+		}
+	}
+	return strings.Join(hex, " ") + "  " + string(ascii)
+}
+
 //  === THIS SECTION IS TO FIND SIMILAR STRINGS IN A SLICE ===
 
 // findSimilarStrings finds similar strings in a slice
@@ -680,21 +2028,6 @@ func min3(a, b, c int) int {
 	return c
 }
 
-// auxiliary function min/max
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
-func max(a, b int) int {
-	if a > b {
-		return a
-	}
-	return b
-}
-
 func formatContainsError(target interface{}, result containResult) string {
 	var msg strings.Builder
 
@@ -1211,6 +2544,10 @@ func findComparableDuplicates(collection any) []duplicateGroup {
 			duplicates = append(duplicates, duplicateGroup{Value: item, Indexes: idxs})
 		}
 	}
+	// Report the groups in the order they first appear in the collection
+	sort.Slice(duplicates, func(i, j int) bool {
+		return duplicates[i].Indexes[0] < duplicates[j].Indexes[0]
+	})
 	return duplicates
 }
 
@@ -1238,72 +2575,7 @@ func formatDuplicatesErrors(duplicates []duplicateGroup) string {
 }
 
 func formatDuplicateItem(item any) string {
-	if item == nil {
-		return "nil"
-	}
-
-	rv := reflect.ValueOf(item)
-	rt := reflect.TypeOf(item)
-
-	// For structs, use special formatting
-	if rv.Kind() == reflect.Struct {
-		return formatStructForDuplicates(rv, rt)
-	}
-
-	// For other types, use the existing formatComparisonValue
-	return formatComparisonValue(item)
-}
-
-func formatStructForDuplicates(rv reflect.Value, rt reflect.Type) string {
-	var parts []string
-	charCount := 0
-	maxChars := 80
-
-	typeName := rt.Name()
-	if typeName == "" {
-		typeName = "struct"
-	}
-
-	result := typeName + "{"
-
-	for i := 0; i < rv.NumField(); i++ {
-		field := rt.Field(i)
-		fieldValue := rv.Field(i)
-
-		if !field.IsExported() {
-			continue
-		}
-
-		fieldStr := fmt.Sprintf("%s: %v", field.Name, formatFieldForDuplicates(fieldValue))
-
-		if charCount+len(fieldStr) > maxChars && len(parts) > 0 {
-			parts = append(parts, "...")
-			break
-		}
-
-		parts = append(parts, fieldStr)
-		charCount += len(fieldStr)
-	}
-
-	result += strings.Join(parts, ", ") + "}"
-	return result
-}
-
-func formatFieldForDuplicates(rv reflect.Value) string {
-	switch rv.Kind() {
-	case reflect.String:
-		str := rv.String()
-		if len(str) > 20 {
-			return fmt.Sprintf("%q", str[:17]+"...")
-		}
-		return fmt.Sprintf("%q", str)
-	case reflect.Struct:
-		return fmt.Sprintf("%s{...}", rv.Type().Name())
-	case reflect.Map, reflect.Slice, reflect.Array:
-		return fmt.Sprintf("%s(...)", rv.Type().String())
-	default:
-		return fmt.Sprintf("%v", rv.Interface())
-	}
+	return formatComplexType(item)
 }
 
 func formatIndexesWindow(indexes []int, windowSize int) string {
@@ -1788,7 +3060,7 @@ func containsMapKey(mapValue interface{}, targetKey interface{}) mapContainResul
 		return result
 	}
 
-	keys := v.MapKeys()
+	keys := sortedMapKeys(v)
 	result.Total = len(keys)
 
 	// Extract all keys as interface{}
@@ -1866,7 +3138,7 @@ func containsMapValue(mapValue interface{}, targetValue interface{}) mapContainR
 		return result
 	}
 
-	keys := v.MapKeys()
+	keys := sortedMapKeys(v)
 	result.Total = len(keys)
 
 	// Extract all values as interface{}
@@ -2226,162 +3498,14 @@ func formatMapContainValueError(target interface{}, result mapContainResult) str
 }
 
 // formatComplexType formats a complex type (like a struct) with truncation for better readability.
+// Nested structs, maps and slices are elided, as in "User{Name: "Ann", Address: Address{...}}".
 func formatComplexType(item any) string {
-	if item == nil {
-		return "nil"
-	}
-
-	rv := reflect.ValueOf(item)
-
-	// If it's a pointer, dereference it
-	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
-		rv = rv.Elem()
-	}
-
-	rt := rv.Type()
-
-	if rv.Kind() == reflect.Struct {
-		return formatStructWithTruncation(rv, rt)
-	}
-
-	// Fallback for non-struct types
-	return formatComparisonValue(item)
-}
-
-// formatStructWithTruncation creates a truncated string representation of a struct.
-func formatStructWithTruncation(rv reflect.Value, rt reflect.Type) string {
-	var parts []string
-	charCount := 0
-	maxChars := 80 // Same as formatStructForDuplicates
-
-	typeName := rt.Name()
-	if typeName == "" {
-		typeName = "struct"
-	}
-
-	result := typeName + "{"
-
-	for i := 0; i < rv.NumField(); i++ {
-		field := rt.Field(i)
-		fieldValue := rv.Field(i)
-
-		if !field.IsExported() {
-			continue
-		}
-
-		fieldStr := fmt.Sprintf("%s: %v", field.Name, formatFieldWithTruncation(fieldValue))
-
-		if charCount+len(fieldStr) > maxChars && len(parts) > 0 {
-			parts = append(parts, "...")
-			break
-		}
-
-		parts = append(parts, fieldStr)
-		charCount += len(fieldStr)
-	}
-
-	result += strings.Join(parts, ", ") + "}"
-	return result
-}
-
-// formatFieldWithTruncation creates a truncated string representation of a field's value.
-func formatFieldWithTruncation(rv reflect.Value) string {
-	if !rv.IsValid() {
-		return "nil"
-	}
-
-	switch rv.Kind() {
-	case reflect.String:
-		str := rv.String()
-		if len(str) > 20 {
-			return fmt.Sprintf("%q", str[:17]+"...")
-		}
-		return fmt.Sprintf("%q", str)
-	case reflect.Ptr:
-		if rv.IsNil() {
-			return "nil"
-		}
-		return formatFieldWithTruncation(rv.Elem())
-	case reflect.Struct:
-		if !rv.IsValid() || rv.IsZero() {
-			return fmt.Sprintf("%s{}", rv.Type().Name())
-		}
-		return fmt.Sprintf("%s{...}", rv.Type().Name())
-	case reflect.Map, reflect.Slice, reflect.Array:
-		if rv.IsNil() {
-			return "nil"
-		}
-		if rv.Len() == 0 {
-			return fmt.Sprintf("%s{}", rv.Type().String())
-		}
-		return fmt.Sprintf("%s(%d items)", rv.Type().String(), rv.Len())
-	default:
-		return fmt.Sprintf("%v", rv.Interface())
-	}
+	return (&printer{maxDepth: 1, maxString: 20, maxLength: 80}).print(reflect.ValueOf(item))
 }
 
 // formatDiffValueConcise formats a value for difference display with truncation for readability.
 func formatDiffValueConcise(value interface{}) string {
-	if value == nil {
-		return "nil"
-	}
-
-	v := reflect.ValueOf(value)
-	switch v.Kind() {
-	case reflect.String:
-		str := v.String()
-		if len(str) > 30 {
-			return fmt.Sprintf("%q", str[:27]+"...")
-		}
-		return fmt.Sprintf("%q", str)
-	case reflect.Map:
-		if v.IsNil() {
-			return "nil"
-		}
-		if v.Len() == 0 {
-			return "map[]"
-		}
-		if v.Len() == 1 {
-			// Show single entry maps completely
-			keys := v.MapKeys()
-			key := keys[0]
-			val := v.MapIndex(key)
-			return fmt.Sprintf("map[%v: %v]", formatDiffValueConcise(key.Interface()), formatDiffValueConcise(val.Interface()))
-		}
-		// For maps with multiple entries, show count
-		return fmt.Sprintf("map[%d entries]", v.Len())
-	case reflect.Slice, reflect.Array:
-		if v.IsNil() {
-			return "nil"
-		}
-		if v.Len() == 0 {
-			return "[]"
-		}
-		if v.Len() <= 3 {
-			// Show small slices completely
-			var elements []string
-			for i := 0; i < v.Len(); i++ {
-				elements = append(elements, formatDiffValueConcise(v.Index(i).Interface()))
-			}
-			return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
-		}
-		// For larger slices, show count
-		return fmt.Sprintf("[%d items]", v.Len())
-	case reflect.Struct:
-		// Use the existing complex type formatting
-		return formatComplexType(value)
-	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-		reflect.Float32, reflect.Float64:
-		return fmt.Sprint(value)
-	default:
-		// For other types, use a simple representation
-		str := fmt.Sprint(value)
-		if len(str) > 40 {
-			return str[:37] + "..."
-		}
-		return str
-	}
+	return (&printer{maxDepth: 1, maxElements: 3, maxString: 30, maxLength: 80}).print(reflect.ValueOf(value))
 }
 
 // formatMapNotContainKeyError formats error message for NotContainKey assertion
@@ -2424,7 +3548,7 @@ func formatMapNotContainValueError(target interface{}, mapValue interface{}) str
 	msg.WriteString(fmt.Sprintf("Found Value: %s\n", formatComplexType(target)))
 
 	// Find which key(s) contain this value
-	keys := v.MapKeys()
+	keys := sortedMapKeys(v)
 	var foundKeys []string
 	for _, key := range keys {
 		val := v.MapIndex(key)
@@ -2716,3 +3840,171 @@ func formatNotBeErrorMessage(err error) string {
 
 	return msg.String()
 }
+
+// formatGroupSummary formats the failures collected by a Group as a numbered list,
+// each entry showing the source line of the failed check followed by its message.
+func formatGroupSummary(failures []groupFailure, stopped bool, maxFailures int) string {
+	var msg strings.Builder
+
+	noun := "failures"
+	if len(failures) == 1 {
+		noun = "failure"
+	}
+	fmt.Fprintf(&msg, "Group recorded %d %s:\n", len(failures), noun)
+	writeRecordedFailures(&msg, failures)
+
+	switch {
+	case stopped && maxFailures > 0 && len(failures) >= maxFailures:
+		fmt.Fprintf(&msg, "\nGroup stopped after reaching the limit of %d failures", maxFailures)
+	case stopped:
+		msg.WriteString("\nGroup stopped early by a fatal failure")
+	}
+
+	return strings.TrimRight(msg.String(), "\n")
+}
+
+// writeRecordedFailures writes each failure as a numbered entry with its source line,
+// indenting the failure message below it.
+func writeRecordedFailures(msg *strings.Builder, failures []groupFailure) {
+	for i, f := range failures {
+		fmt.Fprintf(msg, "\n%d) %s\n", i+1, f.Location)
+		if f.Message == "" {
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimRight(f.Message, "\n"), "\n") {
+			if line == "" {
+				msg.WriteString("\n")
+				continue
+			}
+			fmt.Fprintf(msg, "   %s\n", line)
+		}
+	}
+}
+
+// formatEventuallyError formats the failure of an Eventually assertion, showing the
+// failures captured on the last completed attempt and whether a later attempt was still
+// running at the timeout.
+func formatEventuallyError(timeout, elapsed time.Duration, attempts int, failures []groupFailure, running bool) string {
+	var msg strings.Builder
+
+	fmt.Fprintf(&msg, "Expected condition to be met within %s, but it was not\n", humanizeDuration(timeout))
+	fmt.Fprintf(&msg, "Attempts: %d in %s\n", attempts, humanizeDuration(elapsed))
+	switch {
+	case !running:
+		msg.WriteString("Last attempt failed with:\n")
+		writeRecordedFailures(&msg, failures)
+	case len(failures) > 0:
+		msg.WriteString("Last attempt was still running when the timeout elapsed\n")
+		msg.WriteString("Last completed attempt failed with:\n")
+		writeRecordedFailures(&msg, failures)
+	default:
+		msg.WriteString("Last attempt was still running when the timeout elapsed\n")
+	}
+
+	return strings.TrimRight(msg.String(), "\n")
+}
+
+// formatConsistentlyError formats the failure of a Consistently assertion, showing the
+// failures captured on the attempt that broke the condition, or that it was still
+// running when the duration elapsed.
+func formatConsistentlyError(duration, elapsed time.Duration, attempts int, failures []groupFailure, running bool) string {
+	var msg strings.Builder
+
+	fmt.Fprintf(&msg, "Expected condition to hold for %s, but it failed after %s\n",
+		humanizeDuration(duration), humanizeDuration(elapsed))
+	fmt.Fprintf(&msg, "Attempts: %d\n", attempts)
+	if running {
+		msg.WriteString("Last attempt was still running when the duration elapsed\n")
+		return strings.TrimRight(msg.String(), "\n")
+	}
+	msg.WriteString("Failed with:\n")
+	writeRecordedFailures(&msg, failures)
+
+	return strings.TrimRight(msg.String(), "\n")
+}
+
+// formatMatcherError formats a failure where no element satisfied a matcher, showing
+// the element that came closest and the reason it was rejected.
+func formatMatcherError(header, kind, collection string, m Matcher, labels []string, values []any) string {
+	var msg strings.Builder
+
+	width := len(kind)
+	if width < len("Matcher") {
+		width = len("Matcher")
+	}
+	field := func(name, value string) {
+		fmt.Fprintf(&msg, "\n  %-*s: %s", width, name, value)
+	}
+
+	msg.WriteString(header)
+	field(kind, collection)
+	field("Matcher", m.Description())
+
+	if closest := closestMatch(m, values); closest >= 0 {
+		field("Closest", fmt.Sprintf("%s %s", labels[closest], formatComparisonValue(values[closest])))
+		field("Because", m.Explain(values[closest]))
+	}
+
+	return msg.String()
+}
+
+// formatMatchFoundError formats a failure where some elements satisfied a matcher they
+// were expected not to, listing every element that matched.
+func formatMatchFoundError(header, kind, collection string, m Matcher, labels []string, values []any) string {
+	var msg strings.Builder
+
+	width := len(kind)
+	if width < len("Matcher") {
+		width = len("Matcher")
+	}
+	field := func(name, value string) {
+		fmt.Fprintf(&msg, "\n  %-*s: %s", width, name, value)
+	}
+
+	msg.WriteString(header)
+	field(kind, collection)
+	field("Matcher", m.Description())
+	for i, value := range values {
+		if m.Match(value) {
+			field("Found", fmt.Sprintf("%s %s", labels[i], formatComparisonValue(value)))
+		}
+	}
+
+	return msg.String()
+}
+
+// formatOneOfMatcherError formats a BeOneOf failure for options that include matchers,
+// explaining why each option rejected the value.
+func formatOneOfMatcherError[T any](actual T, options []T) string {
+	var msg strings.Builder
+	msg.WriteString("Expected value to be one of the allowed options:\n")
+	msg.WriteString(fmt.Sprintf("Value   : %s\n", formatComparisonValue(actual)))
+
+	const maxShown = 4
+	descriptions := make([]string, 0, maxShown)
+	reasons := make([]string, 0, maxShown)
+	for i, opt := range options {
+		if i == maxShown {
+			break
+		}
+		matcher, ok := any(opt).(Matcher)
+		if !ok {
+			matcher = Equal(opt)
+		}
+		descriptions = append(descriptions, matcher.Description())
+		reasons = append(reasons, fmt.Sprintf("  - %s: %s", matcher.Description(), matcher.Explain(actual)))
+	}
+
+	msg.WriteString("Options : [" + strings.Join(descriptions, ", "))
+	if len(options) > maxShown {
+		msg.WriteString(fmt.Sprintf(", ...] (showing first %d of %d)", maxShown, len(options)))
+	} else {
+		msg.WriteString("]")
+	}
+	msg.WriteString("\n")
+
+	msg.WriteString(fmt.Sprintf("Count   : 0 of %d options matched\n", len(options)))
+	msg.WriteString("Reasons :\n")
+	msg.WriteString(strings.Join(reasons, "\n"))
+	return msg.String()
+}
@@ -1,10 +1,14 @@
 package assert
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
+	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -19,6 +23,14 @@ func (c CustomStringer) String() string {
 	return "CustomStringer(" + c.Value + ")"
 }
 
+type pointerStringer struct {
+	Value string
+}
+
+func (p *pointerStringer) String() string {
+	return "pointerStringer(" + p.Value + ")"
+}
+
 // Custom error types for testing
 type simpleError struct{ msg string }
 
@@ -178,7 +190,7 @@ func TestFormatComparisonValue_Structs(t *testing.T) {
 				Name: "John",
 				Age:  30,
 			},
-			expected: `{Name: "John", Age: 30}`,
+			expected: `Person{Name: "John", Age: 30}`,
 		},
 		{
 			name: "Empty struct",
@@ -186,7 +198,7 @@ func TestFormatComparisonValue_Structs(t *testing.T) {
 				Name: "",
 				Age:  0,
 			},
-			expected: `{Name: "", Age: 0}`,
+			expected: `Person{Name: "", Age: 0}`,
 		},
 		{
 			name: "Embedded struct",
@@ -198,7 +210,7 @@ func TestFormatComparisonValue_Structs(t *testing.T) {
 				Department: "Engineering",
 				Salary:     100000.50,
 			},
-			expected: `{Person: {Name: "Jane", Age: 25}, Department: "Engineering", Salary: 100000.5}`,
+			expected: `Employee{Person: Person{Name: "Jane", Age: 25}, Department: "Engineering", Salary: 100000.5}`,
 		},
 	}
 
@@ -229,7 +241,7 @@ func TestFormatComparisonValue_StructWithUnexportedFields(t *testing.T) {
 		privateVal: "hidden",
 	}
 
-	expected := `{Name: "John", Age: 30}`
+	expected := `Person{Name: "John", Age: 30}`
 	result := formatComparisonValue(person)
 	if result != expected {
 		t.Errorf("formatComparisonValue(%v) = %q, want %q", person, result, expected)
@@ -268,7 +280,7 @@ func TestFormatComparisonValue_Pointers(t *testing.T) {
 				Name:    "John",
 				Address: &address,
 			},
-			expected: `{Name: "John", Address: "123 Main St"}`,
+			expected: `Person{Name: "John", Address: "123 Main St"}`,
 		},
 		{
 			name: "Struct with pointer field (nil)",
@@ -276,7 +288,7 @@ func TestFormatComparisonValue_Pointers(t *testing.T) {
 				Name:    "John",
 				Address: nil,
 			},
-			expected: `{Name: "John", Address: nil}`,
+			expected: `Person{Name: "John", Address: nil}`,
 		},
 	}
 
@@ -1082,6 +1094,96 @@ func TestFormatValueComparison_EdgeCases(t *testing.T) {
 	})
 }
 
+func TestFormatValueComparison_Cycles(t *testing.T) {
+	t.Parallel()
+
+	type Node struct {
+		Name     string
+		Parent   *Node
+		Children []*Node
+	}
+	root := &Node{Name: "root"}
+	child := &Node{Name: "child", Parent: root}
+	root.Children = []*Node{child}
+
+	loop := map[string]any{}
+	loop["self"] = loop
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "pointer back to the root", value: root,
+			expected: `&Node{Name: "root", Parent: nil, Children: [&Node{Name: "child", Parent: <cycle to root>, Children: nil}]}`},
+		{name: "pointer back to a field", value: *root,
+			expected: `Node{Name: "root", Parent: nil, Children: [&Node{Name: "child", Parent: &Node{Name: "root", Parent: nil, ` +
+				`Children: <cycle to .Children>}, Children: nil}]}`},
+		{name: "child with its parent", value: child,
+			expected: `&Node{Name: "child", Parent: &Node{Name: "root", Parent: nil, Children: [<cycle to root>]}, Children: nil}`},
+		{name: "map holding itself", value: map[string]any{"loop": loop},
+			expected: `map["loop": map["self": <cycle to ["loop"]>]]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := formatComparisonValue(tt.value)
+			if got != tt.expected {
+				t.Errorf("formatComparisonValue() =\n%s\nwant:\n%s", got, tt.expected)
+			}
+			if diff := formatDiffValue(tt.value); diff != got {
+				t.Errorf("formatDiffValue() =\n%s\nwant:\n%s", diff, got)
+			}
+		})
+	}
+}
+
+func TestSortedMapKeys(t *testing.T) {
+	t.Parallel()
+
+	type point struct{ X, Y int }
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "integers", value: map[int]bool{10: true, -1: true, 2: true}, expected: "[-1, 2, 10]"},
+		{name: "floats", value: map[float64]bool{2.5: true, 0.5: true, 10: true}, expected: "[0.5, 2.5, 10]"},
+		{name: "strings", value: map[string]int{"b": 1, "B": 2, "a": 3}, expected: `["B", "a", "b"]`},
+		{name: "booleans", value: map[bool]int{true: 1, false: 0}, expected: "[false, true]"},
+		{name: "structs", value: map[point]int{{2, 1}: 1, {10, 0}: 2, {1, 5}: 3},
+			expected: "[point{X: 1, Y: 5}, point{X: 10, Y: 0}, point{X: 2, Y: 1}]"},
+		{name: "interface keys", value: map[any]int{"b": 1, 3: 2, "a": 3, 1: 4, true: 5},
+			expected: `[true, 1, 3, "a", "b"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for run := 0; run < 10; run++ {
+				var keys []any
+				for _, key := range sortedMapKeys(reflect.ValueOf(tt.value)) {
+					keys = append(keys, key.Interface())
+				}
+				if got := formatComparisonValue(keys); got != tt.expected {
+					t.Fatalf("sortedMapKeys() = %s, want %s", got, tt.expected)
+				}
+			}
+		})
+	}
+
+	t.Run("maps are formatted in key order", func(t *testing.T) {
+		t.Parallel()
+		value := map[string]map[int]string{"b": {2: "x", 1: "y"}, "a": nil}
+		expected := `map["a": nil, "b": map[1: "y", 2: "x"]]`
+		if got := formatComparisonValue(value); got != expected {
+			t.Errorf("formatComparisonValue() = %s, want %s", got, expected)
+		}
+	})
+}
+
 func TestLevenshteinDistance(t *testing.T) {
 	t.Parallel()
 
@@ -1973,7 +2075,7 @@ func TestFormatComplexType(t *testing.T) {
 		{
 			name:     "pointer to struct",
 			input:    &SimpleStruct{Name: "Jane", Age: 25},
-			expected: "SimpleStruct{Name: \"Jane\", Age: 25}",
+			expected: "&SimpleStruct{Name: \"Jane\", Age: 25}",
 		},
 		{
 			name:     "non-struct type",
@@ -1993,7 +2095,7 @@ func TestFormatComplexType(t *testing.T) {
 	}
 }
 
-func TestFormatStructWithTruncation(t *testing.T) {
+func TestFormatComplexType_Truncation(t *testing.T) {
 	t.Parallel()
 	type LongStruct struct {
 		Field1 string
@@ -2011,9 +2113,7 @@ func TestFormatStructWithTruncation(t *testing.T) {
 		Field5: "final long value",
 	}
 
-	v := reflect.ValueOf(longStruct)
-	structType := reflect.TypeOf(longStruct)
-	result := formatStructWithTruncation(v, structType)
+	result := formatComplexType(longStruct)
 
 	if !strings.Contains(result, "LongStruct{") {
 		t.Errorf("Expected result to contain struct name, got: %s", result)
@@ -2023,62 +2123,105 @@ func TestFormatStructWithTruncation(t *testing.T) {
 	}
 }
 
-func TestFormatFieldWithTruncation(t *testing.T) {
+func TestPrinter(t *testing.T) {
 	t.Parallel()
+
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type User struct {
+		Name    string
+		Tags    []string
+		Address *Address
+		Scores  map[string]int
+	}
+	user := User{
+		Name:    "Ann",
+		Tags:    []string{"a", "b", "c", "d"},
+		Address: &Address{City: "Lisbon", Zip: "1000-001"},
+		Scores:  map[string]int{"math": 9, "art": 7},
+	}
+
 	tests := []struct {
 		name     string
-		input    interface{}
+		printer  printer
+		input    any
 		expected string
 	}{
 		{
-			name:     "short string",
-			input:    "hello",
-			expected: `"hello"`,
+			name:  "type names and pointer markers",
+			input: &user,
+			expected: `&User{Name: "Ann", Tags: ["a", "b", "c", "d"], ` +
+				`Address: &Address{City: "Lisbon", Zip: "1000-001"}, Scores: map["art": 7, "math": 9]}`,
 		},
 		{
-			name:     "long string",
-			input:    "this is a very long string that should be truncated",
-			expected: `"this is a very lo..."`,
+			name:     "anonymous structs",
+			input:    struct{ ID int }{ID: 1},
+			expected: "{ID: 1}",
 		},
 		{
-			name:     "nil pointer",
-			input:    (*string)(nil),
-			expected: "nil",
+			name:     "values held in interfaces",
+			printer:  printer{maxString: 8},
+			input:    []any{1, "1", nil, "a long string", user.Address},
+			expected: `[1, "1", nil, "a lon...", &Address{City: "Lisbon", Zip: "1000-001"}]`,
 		},
 		{
-			name:     "empty slice",
-			input:    []string{},
-			expected: "[]string{}",
+			name:     "errors and stringers",
+			input:    []any{errors.New("boom"), simpleError{msg: "bad"}, CustomStringer{Value: "x"}, &pointerStringer{Value: "y"}},
+			expected: "[boom, bad, CustomStringer(x), pointerStringer(y)]",
 		},
 		{
-			name:     "slice with items",
-			input:    []string{"a", "b", "c"},
-			expected: "[]string(3 items)",
+			name:     "nil values of every kind",
+			input:    []any{(chan int)(nil), (func())(nil), (*int)(nil), []int(nil), map[int]int(nil), error(nil)},
+			expected: "[nil, nil, nil, nil, nil, nil]",
 		},
 		{
-			name:     "empty map",
-			input:    map[string]int{},
-			expected: "map[string]int{}",
+			name:     "max depth",
+			printer:  printer{maxDepth: 1},
+			input:    []any{user, Address{}, []string{}, map[int]int{1: 1}, (*Address)(nil)},
+			expected: "[User{...}, Address{}, [], map[int]int(1 items), nil]",
 		},
 		{
-			name:     "map with items",
-			input:    map[string]int{"a": 1, "b": 2},
-			expected: "map[string]int(2 items)",
+			name:     "max elements",
+			printer:  printer{maxElements: 2},
+			input:    map[int][]int{1: {1, 2, 3}, 2: {}, 3: nil},
+			expected: "map[1: [1, 2, ... (1 more)], 2: [], ... (1 more)]",
 		},
 		{
-			name:     "integer",
-			input:    42,
-			expected: "42",
+			name:     "max string",
+			printer:  printer{maxString: 20},
+			input:    "this is a very long string that should be truncated",
+			expected: `"this is a very lo..."`,
+		},
+		{
+			name:     "max length",
+			printer:  printer{maxLength: 40},
+			input:    user,
+			expected: `User{Name: "Ann", Tags: ["a", "b", "c", "d"], ...}`,
+		},
+		{
+			name:    "max width",
+			printer: printer{maxWidth: 40},
+			input:   &user,
+			expected: `&User{
+  Name: "Ann",
+  Tags: ["a", "b", "c", "d"],
+  Address: &Address{
+    City: "Lisbon",
+    Zip: "1000-001",
+  },
+  Scores: map["art": 7, "math": 9],
+}`,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
-			v := reflect.ValueOf(test.input)
-			result := formatFieldWithTruncation(v)
+			result := test.printer.print(reflect.ValueOf(test.input))
 			if result != test.expected {
-				t.Errorf("formatFieldWithTruncation(%s): expected %q, got %q", test.name, test.expected, result)
+				t.Errorf("print(%s) =\n%s\nwant:\n%s", test.name, result, test.expected)
 			}
 		})
 	}
@@ -2118,8 +2261,8 @@ func TestFormatDiffValueConcise(t *testing.T) {
 		},
 		{
 			name:     "multi entry map",
-			input:    map[string]int{"a": 1, "b": 2, "c": 3},
-			expected: "map[3 entries]",
+			input:    map[string]int{"a": 1, "b": 2, "c": 3, "d": 4},
+			expected: `map["a": 1, "b": 2, "c": 3, ... (1 more)]`,
 		},
 		{
 			name:     "empty slice",
@@ -2134,7 +2277,7 @@ func TestFormatDiffValueConcise(t *testing.T) {
 		{
 			name:     "large slice",
 			input:    []int{1, 2, 3, 4, 5},
-			expected: "[5 items]",
+			expected: "[1, 2, 3, ... (2 more)]",
 		},
 		{
 			name:     "boolean",
@@ -4742,3 +4885,287 @@ func TestFormatBeWithinError(t *testing.T) {
 		})
 	})
 }
+
+func TestFormatLineDiff(t *testing.T) {
+	t.Parallel()
+
+	lines := func(from, to int) []string {
+		var result []string
+		for i := from; i <= to; i++ {
+			result = append(result, fmt.Sprintf("line %d", i))
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		want     string
+	}{
+		{
+			name:     "single change",
+			expected: "SELECT id, name\nFROM users\nWHERE active = true",
+			actual:   "SELECT id, name\nFROM accounts\nWHERE active = true",
+			want: "--- expected\n+++ actual\n@@ -1,3 +1,3 @@\n" +
+				" SELECT id, name\n-FROM users\n+FROM accounts\n WHERE active = true",
+		},
+		{
+			name:     "distant changes get separate hunks",
+			expected: strings.Join(lines(1, 20), "\n"),
+			actual:   strings.Join(append(append(append([]string{"line 0"}, lines(1, 14)...), "inserted"), lines(16, 20)...), "\n"),
+			want: "--- expected\n+++ actual\n" +
+				"@@ -1,3 +1,4 @@\n+line 0\n line 1\n line 2\n line 3\n" +
+				"@@ -12,7 +13,7 @@\n line 12\n line 13\n line 14\n-line 15\n+inserted\n line 16\n line 17\n line 18",
+		},
+		{
+			name:     "close changes share a hunk",
+			expected: "a\nb\nc\nd\ne\nf\ng",
+			actual:   "A\nb\nc\nd\ne\nf\nG",
+			want:     "--- expected\n+++ actual\n@@ -1,7 +1,7 @@\n-a\n+A\n b\n c\n d\n e\n f\n-g\n+G",
+		},
+		{
+			name:     "trailing newline",
+			expected: "done\n",
+			actual:   "done",
+			want:     "--- expected\n+++ actual\n@@ -1,2 +1,1 @@\n done\n-",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := formatLineDiff(tt.expected, tt.actual); got != tt.want {
+				t.Errorf("formatLineDiff() =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCharDiff(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("abcdefghij", 3)
+
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		want     string
+	}{
+		{
+			name:     "typo",
+			expected: "hello world",
+			actual:   "hello wrold",
+			want:     "\n                 ^\nFirst difference at position 8 (possible typo)",
+		},
+		{
+			name:     "case difference",
+			expected: "hello",
+			actual:   "Hello",
+			want:     "\n          ^\nFirst difference at position 1 (case difference)",
+		},
+		{
+			name:     "missing suffix",
+			expected: "user-42-admin",
+			actual:   "user-42",
+			want:     "\n                 ^\nFirst difference at position 8 (actual is missing suffix \"-admin\")",
+		},
+		{
+			name:     "extra prefix",
+			expected: "42",
+			actual:   "id-42",
+			want:     "\n          ^\nFirst difference at position 1 (actual has extra prefix \"id-\")",
+		},
+		{
+			name:     "unrelated strings",
+			expected: "apple",
+			actual:   "zebra",
+			want:     "\n          ^\nFirst difference at position 1",
+		},
+		{
+			name:     "multi-byte runes",
+			expected: "café au lait",
+			actual:   "café ou lait",
+			want:     "\n               ^\nFirst difference at position 6 (possible typo)",
+		},
+		{
+			name:     "look-alike characters are not a typo",
+			expected: "hello world",
+			actual:   "hello\u00a0world",
+			want:     "\n               ^\nFirst difference at position 6",
+		},
+		{
+			name:     "long strings show a window",
+			expected: long + "X" + long,
+			actual:   long + "Y" + long,
+			want: "\nAround position 31:" +
+				"\nexpected: ...abcdefghijabcdefghijXabcdefghijabcdefghi..." +
+				"\nactual  : ...abcdefghijabcdefghijYabcdefghijabcdefghi..." +
+				"\n                                 ^" +
+				"\nFirst difference at position 31 (possible typo)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := formatCharDiff(tt.expected, tt.actual); got != tt.want {
+				t.Errorf("formatCharDiff() =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookAlikeSkeleton(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "plain text", input: "hello world", want: "hello world"},
+		{name: "non-breaking and thin spaces", input: "a\u00a0b\u2009c", want: "a b c"},
+		{name: "zero width characters", input: "a\u200bb\u200dc\ufeff", want: "abc"},
+		{name: "line endings and trailing spaces", input: "a \r\nb\t", want: "a\nb"},
+		{name: "Cyrillic and Greek look-alikes", input: "рауpal Οk", want: "paypal Ok"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := lookAlikeSkeleton(tt.input); got != tt.want {
+				t.Errorf("lookAlikeSkeleton(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatLookAlikeNote(t *testing.T) {
+	t.Parallel()
+
+	got := formatLookAlikeNote([]string{"expected", "actual  "}, []string{"pay pal\r", "раy\u00a0pal\u200b "})
+	want := "\nNote: the strings look alike but differ in invisible or look-alike characters:" +
+		"\n  expected: pay␠pal\\r" +
+		"\n    └─ position 8: U+000D CARRIAGE RETURN (Cc, control)" +
+		"\n  actual  : <U+0440><U+0430>y<U+00A0>pal<U+200B>␠" +
+		"\n    └─ position 1: U+0440 Cyrillic letter 'р', looks like Latin 'p' (Ll, lowercase letter)" +
+		"\n    └─ position 2: U+0430 Cyrillic letter 'а', looks like Latin 'a' (Ll, lowercase letter)" +
+		"\n    └─ position 4: U+00A0 NO-BREAK SPACE (Zs, space separator)" +
+		"\n    └─ position 8: U+200B ZERO WIDTH SPACE (Cf, format)" +
+		"\n    └─ position 9: trailing U+0020 SPACE (Zs, space separator)"
+	if got != want {
+		t.Errorf("formatLookAlikeNote() =\n%s\nwant:\n%s", got, want)
+	}
+
+	if note := lookAlikeEqualityNote("apple", "apples"); note != "" {
+		t.Errorf("Expected no note for strings that look different, got:\n%s", note)
+	}
+}
+
+func TestFormatHexDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("differing rows with context", func(t *testing.T) {
+		t.Parallel()
+		expected := []byte("Hello, world!\n\x00\x01")
+		actual := []byte("Hello, world!\n\x00\x02\xff")
+
+		want := "Bytes differ at offset 15 (expected 16 bytes, actual 17 bytes):" +
+			"\n  offset    expected                           actual" +
+			"\n  00000000  48 65 6c 6c 6f 2c 20 77  Hello, w  48 65 6c 6c 6f 2c 20 77  Hello, w" +
+			"\n≠ 00000008  6f 72 6c 64 21 0a 00 01  orld!...  6f 72 6c 64 21 0a 00 02  orld!..." +
+			"\n≠ 00000010                                     ff                       ."
+		if got := formatHexDiff(expected, actual); got != want {
+			t.Errorf("formatHexDiff() =\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("distant differences are separated", func(t *testing.T) {
+		t.Parallel()
+		expected := make([]byte, 128)
+		actual := make([]byte, 128)
+		actual[0], actual[127] = 1, 1
+
+		got := formatHexDiff(expected, actual)
+		for _, part := range []string{"\n≠ 00000000", "\n  00000010", "\n  ...\n  00000068", "\n≠ 00000078"} {
+			if !strings.Contains(got, part) {
+				t.Errorf("Expected hexdump to contain %q, got:\n%s", part, got)
+			}
+		}
+		if strings.Contains(got, "00000040") {
+			t.Errorf("Expected rows far from the differences to be left out, got:\n%s", got)
+		}
+	})
+
+	t.Run("large payloads are capped", func(t *testing.T) {
+		t.Parallel()
+		expected := make([]byte, 4096)
+		actual := bytes.Repeat([]byte{1}, 4096)
+
+		got := formatHexDiff(expected, actual)
+		if rows := strings.Count(got, "\n≠ "); rows != maxHexDumpRows {
+			t.Errorf("Expected %d differing rows, got %d", maxHexDumpRows, rows)
+		}
+		if !strings.HasSuffix(got, "\n  ... (more differences not shown)") {
+			t.Errorf("Expected a truncation note, got:\n%s", got)
+		}
+	})
+}
+
+func TestScrubString(t *testing.T) {
+	t.Parallel()
+
+	tempDir := filepath.Join(os.TempDir(), "TestUpload123")
+
+	tests := []struct {
+		name  string
+		opts  []Option
+		input string
+		want  string
+	}{
+		{
+			name:  "UUIDs",
+			opts:  []Option{WithScrubUUIDs()},
+			input: "user 3F2504E0-4F89-11D3-9A0C-0305E82C3301 created 123e4567-e89b-12d3-a456-4266141740001",
+			want:  "user <UUID> created 123e4567-e89b-12d3-a456-4266141740001",
+		},
+		{
+			name:  "RFC 3339 timestamps",
+			opts:  []Option{WithScrubTimestamps()},
+			input: `{"created":"2024-05-01T10:20:30Z","updated":"2024-05-01T10:20:30.123+02:00"}`,
+			want:  `{"created":"<TIMESTAMP>","updated":"<TIMESTAMP>"}`,
+		},
+		{
+			name:  "time.Time strings",
+			opts:  []Option{WithScrubTimestamps()},
+			input: "at 2024-05-01 10:20:30.5 +0000 UTC, retry",
+			want:  "at <TIMESTAMP>, retry",
+		},
+		{
+			name:  "temporary directories",
+			opts:  []Option{WithScrubTempDir()},
+			input: "wrote " + filepath.Join(tempDir, "001", "out.txt") + " and " + os.TempDir() + "x",
+			want:  "wrote " + filepath.Join("<TMPDIR>", "001", "out.txt") + " and " + os.TempDir() + "x",
+		},
+		{
+			name: "custom scrubbers run in order",
+			opts: []Option{
+				WithScrubber(regexp.MustCompile(`:(\d{4,5})\b`), ":<PORT>"),
+				WithScrubber(regexp.MustCompile(`<PORT>`), "$0!"),
+			},
+			input: "listening on 127.0.0.1:54321",
+			want:  "listening on 127.0.0.1:<PORT>!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := scrubString(processOptions(tt.opts...), tt.input); got != tt.want {
+				t.Errorf("scrubString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Package mustgen generates the `must` package from the `should` package.
+//
+// Both packages delegate to the same implementation in `assert`. Every assertion
+// declared in should.go is mirrored in must.go with an identical signature; the
+// generated wrapper calls the shared assertion and stops the test with t.FailNow
+// when it reports a failure. Type aliases and the constructors of aliased types,
+// such as options and matchers, are copied verbatim, so the two surfaces cannot
+// drift apart.
+package mustgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// Header is the first line of the generated file.
+const Header = "// Code generated by gen.go from should.go; DO NOT EDIT."
+
+const failNowNote = "On failure, the test is stopped immediately via t.FailNow."
+
+// Generate returns the formatted source of must.go for the given should.go source.
+func Generate(shouldSrc []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "should.go", shouldSrc, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing should.go: %w", err)
+	}
+
+	g := &generator{fset: fset, src: shouldSrc, aliases: make(map[string]bool)}
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok == token.TYPE {
+				g.typeDecl(d)
+			}
+		case *ast.FuncDecl:
+			if err := g.funcDecl(d); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString(Header + "\n\n")
+	out.WriteString("package must\n\n")
+	var std, other []string
+	for _, imp := range file.Imports {
+		if !g.uses(importName(imp)) {
+			continue
+		}
+		if strings.Contains(strings.SplitN(imp.Path.Value, "/", 2)[0], ".") {
+			other = append(other, g.text(imp))
+		} else {
+			std = append(std, g.text(imp))
+		}
+	}
+	out.WriteString("import (\n")
+	for _, imp := range std {
+		out.WriteString("\t" + imp + "\n")
+	}
+	if len(std) > 0 && len(other) > 0 {
+		out.WriteString("\n")
+	}
+	for _, imp := range other {
+		out.WriteString("\t" + imp + "\n")
+	}
+	out.WriteString(")\n")
+	out.Write(g.body.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting must.go: %w", err)
+	}
+	return formatted, nil
+}
+
+type generator struct {
+	fset    *token.FileSet
+	src     []byte
+	body    bytes.Buffer
+	aliases map[string]bool // names of the type aliases declared in should.go
+}
+
+// typeDecl copies a type declaration and remembers the aliases it declares.
+func (g *generator) typeDecl(d *ast.GenDecl) {
+	for _, spec := range d.Specs {
+		if ts, ok := spec.(*ast.TypeSpec); ok && ts.Assign.IsValid() {
+			g.aliases[ts.Name.Name] = true
+		}
+	}
+	g.copyDecl(d.Doc, d)
+}
+
+// funcDecl mirrors constructors verbatim and wraps assertions with t.FailNow.
+func (g *generator) funcDecl(fn *ast.FuncDecl) error {
+	if fn.Recv != nil || !fn.Name.IsExported() {
+		return nil
+	}
+
+	if g.isConstructor(fn) {
+		g.copyDecl(fn.Doc, fn)
+		return nil
+	}
+
+	params := fn.Type.Params.List
+	if len(params) == 0 || !isTestingParam(params[0].Type) {
+		return nil
+	}
+	if len(params[0].Names) != 1 {
+		return fmt.Errorf("%s: the testing parameter must be declared on its own", fn.Name.Name)
+	}
+
+	var args []string
+	for _, field := range params {
+		if len(field.Names) == 0 {
+			return fmt.Errorf("%s: all parameters must be named", fn.Name.Name)
+		}
+		for _, name := range field.Names {
+			args = append(args, name.Name)
+		}
+	}
+	if _, variadic := params[len(params)-1].Type.(*ast.Ellipsis); variadic {
+		args[len(args)-1] += "..."
+	}
+
+	typeArgs := ""
+	if fn.Type.TypeParams != nil {
+		var names []string
+		for _, field := range fn.Type.TypeParams.List {
+			for _, name := range field.Names {
+				names = append(names, name.Name)
+			}
+		}
+		typeArgs = "[" + strings.Join(names, ", ") + "]"
+	}
+
+	t := params[0].Names[0].Name
+	call := fmt.Sprintf("assert.%s%s(%s)", fn.Name.Name, typeArgs, strings.Join(args, ", "))
+
+	g.writeDoc(fn.Doc, true)
+	g.body.WriteString("func " + fn.Name.Name)
+	if fn.Type.TypeParams != nil {
+		g.body.WriteString(g.text(fn.Type.TypeParams))
+	}
+	g.body.WriteString(g.text(fn.Type.Params))
+
+	results := fn.Type.Results
+	switch {
+	case results == nil || len(results.List) == 0:
+		fmt.Fprintf(&g.body, " {\n\t%s.Helper()\n\tif !%s {\n\t\t%s.FailNow()\n\t}\n}\n", t, call, t)
+	case results.NumFields() == 1:
+		fmt.Fprintf(&g.body, " %s {\n\t%s.Helper()\n\tresult, ok := %s\n\tif !ok {\n\t\t%s.FailNow()\n\t}\n\treturn result\n}\n",
+			g.text(results.List[0].Type), t, call, t)
+	default:
+		return fmt.Errorf("%s: assertions may return at most one value", fn.Name.Name)
+	}
+	return nil
+}
+
+// copyDecl writes a declaration as it appears in should.go.
+func (g *generator) copyDecl(doc *ast.CommentGroup, node ast.Node) {
+	g.writeDoc(doc, false)
+	g.body.WriteString(g.text(node) + "\n")
+}
+
+// writeDoc copies a doc comment, pointing examples at the must package.
+func (g *generator) writeDoc(doc *ast.CommentGroup, assertion bool) {
+	g.body.WriteString("\n")
+	if doc == nil {
+		return
+	}
+	for _, c := range doc.List {
+		g.body.WriteString(strings.ReplaceAll(c.Text, "should.", "must.") + "\n")
+	}
+	if assertion {
+		g.body.WriteString("//\n// " + failNowNote + "\n")
+	}
+}
+
+// text returns the original source text of a node.
+func (g *generator) text(node ast.Node) string {
+	start := g.fset.Position(node.Pos()).Offset
+	end := g.fset.Position(node.End()).Offset
+	return string(g.src[start:end])
+}
+
+// uses reports whether the generated body references the given package name.
+func (g *generator) uses(pkg string) bool {
+	return bytes.Contains(g.body.Bytes(), []byte(pkg+"."))
+}
+
+func importName(imp *ast.ImportSpec) string {
+	if imp.Name != nil {
+		return imp.Name.Name
+	}
+	path, _ := strconv.Unquote(imp.Path.Value)
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// isConstructor reports whether fn builds a value of one of the aliased types,
+// such as an Option or a Matcher.
+func (g *generator) isConstructor(fn *ast.FuncDecl) bool {
+	results := fn.Type.Results
+	if results == nil || len(results.List) != 1 {
+		return false
+	}
+	ident, ok := results.List[0].Type.(*ast.Ident)
+	return ok && g.aliases[ident.Name]
+}
+
+// isTestingParam reports whether expr is testing.TB or *testing.T.
+func isTestingParam(expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "testing" && (sel.Sel.Name == "TB" || sel.Sel.Name == "T")
+}
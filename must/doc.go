@@ -0,0 +1,22 @@
+// Package must provides the same assertions as the `should` package, but stops
+// the test on the first failure.
+//
+// Each assertion reports its failure exactly like its `should` counterpart and
+// then calls t.FailNow, so the remaining statements of the test are skipped.
+// Use it for preconditions whose failure makes the rest of the test meaningless.
+//
+// Example usage:
+//
+//	func TestUser(t *testing.T) {
+//		user, err := repo.Find(1)
+//		must.NotBeError(t, err)
+//		must.NotBeNil(t, user)
+//
+//		should.BeEqual(t, user.Name, "John")
+//	}
+//
+// The assertions in this package are generated from should.go; run
+// `go generate ./must` after changing the `should` API.
+package must
+
+//go:generate go run gen.go
@@ -0,0 +1,28 @@
+//go:build ignore
+
+// This program generates must.go from should.go. It is invoked by running
+// `go generate` in this directory.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/Kairum-Labs/should/internal/mustgen"
+)
+
+func main() {
+	src, err := os.ReadFile("../should.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out, err := mustgen.Generate(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("must.go", out, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,1459 @@
+// Code generated by gen.go from should.go; DO NOT EDIT.
+
+package must
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Kairum-Labs/should/assert"
+)
+
+// Option is a functional option for configuring assertions.
+type Option = assert.Option
+
+// Matcher describes a condition that a value can satisfy.
+//
+// Matchers can be passed to Contain, ContainValue, NotContainValue and BeOneOf in place
+// of an expected value. When nothing matches, the failure message explains why the closest
+// element was rejected. Implement this interface to write custom matchers.
+type Matcher = assert.Matcher
+
+// G collects the failures of the assertions run inside a Group.
+//
+// It implements testing.TB and can be passed to any assertion in place of t.
+type G = assert.G
+
+// WithMessage creates an option for setting a custom error message.
+//
+// The message is treated as a plain string literal. Use this when you
+// want to display a fixed message without formatting or placeholders.
+//
+// Example usage:
+//
+//	must.BeGreaterThan(t, userAge, 18, must.WithMessage("User must be adult"))
+//
+// See also: [WithMessagef] for messages that include formatting placeholders.
+func WithMessage(message string) Option {
+	return assert.WithMessage(message)
+}
+
+// WithMessagef creates an option for setting a custom error message with formatting.
+//
+// The message supports placeholders, similar to fmt.Sprintf, and takes
+// optional arguments to replace them. Use this when you need dynamic
+// content in the message.
+//
+// Example usage:
+//
+//	must.BeLessOrEqualTo(t, score, 100, must.WithMessagef("Score cannot exceed %d", 100))
+func WithMessagef(message string, args ...any) Option {
+	return assert.WithMessagef(message, args...)
+}
+
+// WithIgnoreCase returns an option that makes string comparisons case-insensitive.
+//
+// This option can be passed to assertions that perform string comparisons,
+// such as StartWith and EndWith, to ensure that case differences are ignored.
+//
+// Example:
+//
+//	must.StartWith(t, "hello", "HELLO", must.WithIgnoreCase())
+//	must.EndWith(t, "Hello, world", "WORLD", must.WithIgnoreCase())
+func WithIgnoreCase() Option {
+	return assert.WithIgnoreCase()
+}
+
+// WithStackTrace creates an option for including stack traces on NotPanic assertions.
+//
+// Example:
+//
+//	must.NotPanic(t, func() {
+//		panic("expected panic")
+//	}, must.WithStackTrace())
+func WithStackTrace() Option {
+	return assert.WithStackTrace()
+}
+
+// WithIgnoreTimezone returns an option that makes time comparisons ignore timezone/location differences.
+//
+// Currently, this option is only supported by BeSameTime.
+//
+// Example:
+//
+//	must.BeSameTime(t, actual, expected, must.WithIgnoreTimezone())
+func WithIgnoreTimezone() Option {
+	return assert.WithIgnoreTimezone()
+}
+
+// WithTruncate truncates the actual and expected times to the specified unit before comparing them for equality.
+//
+// This is useful for asserting that two times are the same up to a certain level of precision,
+// ignoring differences in smaller units.
+//
+// Example:
+//
+//	time1 := time.Date(2024, 8, 10, 15, 30, 0, 1_000_000, time.UTC)
+//	time2 := time.Date(2024, 8, 10, 15, 30, 0, 999_999_999, time.UTC)
+//
+//	// This assertion will pass because both times truncate to 15:30:00.
+//	must.BeSameTime(t, time1, time2, must.WithTruncate(time.Second))
+func WithTruncate(unit time.Duration) Option {
+	return assert.WithTruncate(unit)
+}
+
+// WithIgnoreFields excludes the given field paths from BeEqual and NotBeEqual comparisons.
+//
+// Paths use the same dotted syntax as the "Field differences" report. Use "[*]" to
+// match any slice index or map key. Ignoring a field also ignores everything below it.
+//
+// Example:
+//
+//	must.BeEqual(t, actual, expected, must.WithIgnoreFields("ID", "Meta.CreatedAt", "Items[*].UpdatedAt"))
+func WithIgnoreFields(paths ...string) Option {
+	return assert.WithIgnoreFields(paths...)
+}
+
+// WithUnexportedFields makes BeEqual report differences in unexported struct fields.
+//
+// Unexported fields always take part in the comparison itself. By default, a failure
+// caused only by them just names the fields; with this option, their values are diffed
+// like exported ones.
+//
+// Example:
+//
+//	must.BeEqual(t, actual, expected, must.WithUnexportedFields())
+func WithUnexportedFields() Option {
+	return assert.WithUnexportedFields()
+}
+
+// WithIgnoreOrder makes BeEqual and NotBeEqual compare slices regardless of element order.
+//
+// Without arguments, every slice and array is compared as a multiset, at any depth.
+// Pass paths, using the same syntax as WithIgnoreFields, to limit it to those slices.
+// On failure, the report lists the elements that are missing and the unexpected ones.
+// MatchJSON and ContainJSON apply it to JSON arrays.
+//
+// Example:
+//
+//	must.BeEqual(t, users, expectedUsers, must.WithIgnoreOrder())
+//
+//	must.BeEqual(t, order, expectedOrder, must.WithIgnoreOrder("Items", "Items[*].Tags"))
+func WithIgnoreOrder(paths ...string) Option {
+	return assert.WithIgnoreOrder(paths...)
+}
+
+// WithComparer makes BeEqual and NotBeEqual compare values of type T with the given function.
+//
+// The comparer applies wherever a T appears, including struct fields, slice elements
+// and map values, and takes precedence over the type's own Equal method.
+//
+// Example:
+//
+//	must.BeEqual(t, got, want, must.WithComparer(func(a, b float64) bool {
+//		return math.Abs(a-b) < 1e-9
+//	}))
+func WithComparer[T any](equal func(a, b T) bool) Option {
+	return assert.WithComparer(equal)
+}
+
+// WithGoLiteral makes BeEqual failures also show the actual value as gofmt-ed Go source,
+// ready to be pasted into the test as the new expected value.
+//
+// Type names are qualified with their package name, pointers to basic values are built
+// with a small helper func, times are written as time.Date calls and errors as
+// errors.New calls. A value that refers back to itself, or that holds a value whose type
+// cannot be named outside of its package, has no literal form, so the message says why
+// instead.
+//
+// Example:
+//
+//	must.BeEqual(t, order, expectedOrder, must.WithGoLiteral())
+func WithGoLiteral() Option {
+	return assert.WithGoLiteral()
+}
+
+// WithIgnoreExtraFields makes MatchJSON ignore object keys that are only present in the
+// actual document, at any depth.
+//
+// Example:
+//
+//	must.MatchJSON(t, resp.Body, `{"id": 1}`, must.WithIgnoreExtraFields())
+func WithIgnoreExtraFields() Option {
+	return assert.WithIgnoreExtraFields()
+}
+
+// WithArrayPrefix makes MatchJSON and ContainJSON accept actual arrays that start with the
+// expected elements and continue with more.
+//
+// Without arguments, it applies to every array, at any depth. Pass paths, using the same
+// syntax as WithIgnoreFields, to limit it to those arrays.
+//
+// Example:
+//
+//	must.ContainJSON(t, resp.Body, `{"events": [{"type": "created"}]}`, must.WithArrayPrefix("events"))
+func WithArrayPrefix(paths ...string) Option {
+	return assert.WithArrayPrefix(paths...)
+}
+
+// WithScrubber replaces every match of pattern with replacement before strings are compared.
+//
+// It applies to BeEqual on strings, ContainSubstring, MatchSnapshot and MatchInlineSnapshot,
+// so that volatile content such as IDs, ports or durations does not break them. The
+// replacement may refer to submatches, as in regexp.Regexp.ReplaceAllString, and failure
+// messages show the scrubbed strings. Scrubbers run in the order they are given.
+//
+// Example:
+//
+//	port := regexp.MustCompile(`:\d{2,5}\b`)
+//	must.ContainSubstring(t, logs, "listening on 127.0.0.1:<PORT>", must.WithScrubber(port, ":<PORT>"))
+func WithScrubber(pattern *regexp.Regexp, replacement string) Option {
+	return assert.WithScrubber(pattern, replacement)
+}
+
+// WithScrubUUIDs is a scrubber that replaces UUIDs with "<UUID>".
+//
+// Example:
+//
+//	must.BeEqual(t, receipt, "order <UUID> paid", must.WithScrubUUIDs())
+func WithScrubUUIDs() Option {
+	return assert.WithScrubUUIDs()
+}
+
+// WithScrubTimestamps is a scrubber that replaces RFC 3339 timestamps, and times formatted
+// by time.Time.String, with "<TIMESTAMP>".
+//
+// Example:
+//
+//	must.MatchSnapshot(t, event, must.WithScrubTimestamps())
+func WithScrubTimestamps() Option {
+	return assert.WithScrubTimestamps()
+}
+
+// WithScrubTempDir is a scrubber that replaces the temporary directory, along with the
+// directory created in it by t.TempDir or os.MkdirTemp, with "<TMPDIR>".
+//
+// Example:
+//
+//	must.BeEqual(t, err.Error(), "open <TMPDIR>/001/config.yaml: permission denied", must.WithScrubTempDir())
+func WithScrubTempDir() Option {
+	return assert.WithScrubTempDir()
+}
+
+// WithMaxFailures stops a Group as soon as n failures have been recorded.
+//
+// The failures collected up to that point are still reported in the summary.
+// By default, every check in the group is executed.
+//
+// Example:
+//
+//	must.Group(t, func(g *must.G) {
+//		// ...
+//	}, must.WithMaxFailures(3))
+func WithMaxFailures(n int) Option {
+	return assert.WithMaxFailures(n)
+}
+
+// BeTrue reports a test failure if the value is not true.
+//
+// This assertion only works with boolean values and will fail immediately
+// if the value is not a boolean type.
+//
+// Example:
+//
+//	must.BeTrue(t, true)
+//
+//	must.BeTrue(t, user.IsActive, must.WithMessage("User must be active"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeTrue(t testing.TB, actual bool, opts ...Option) {
+	t.Helper()
+	if !assert.BeTrue(t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeFalse reports a test failure if the value is not false.
+//
+// This assertion only works with boolean values and will fail immediately
+// if the value is not a boolean type.
+//
+// Example:
+//
+//	must.BeFalse(t, false)
+//
+//	must.BeFalse(t, user.IsDeleted, must.WithMessage("User should not be deleted"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeFalse(t testing.TB, actual bool, opts ...Option) {
+	t.Helper()
+	if !assert.BeFalse(t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeEmpty reports a test failure if the value is not empty.
+//
+// This assertion works with strings, slices, arrays, maps, channels, and pointers.
+// For strings, empty means zero length. For slices/arrays/maps/channels, empty means zero length.
+// For pointers, empty means nil. Provides detailed error messages showing the type,
+// length, and content of non-empty values.
+//
+// Example:
+//
+//	must.BeEmpty(t, "")
+//
+//	must.BeEmpty(t, []int{}, must.WithMessage("List should be empty"))
+//
+//	must.BeEmpty(t, map[string]int{})
+//
+// Only works with strings, slices, arrays, maps, channels, or pointers.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeEmpty(t testing.TB, actual any, opts ...Option) {
+	t.Helper()
+	if !assert.BeEmpty(t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotBeEmpty reports a test failure if the value is empty.
+//
+// This assertion works with strings, slices, arrays, maps, channels, and pointers.
+// For strings, non-empty means length > 0. For slices/arrays/maps/channels, non-empty means length > 0.
+// For pointers, non-empty means not nil. Provides detailed error messages for empty values.
+//
+// Example:
+//
+//	must.NotBeEmpty(t, "hello")
+//
+//	must.NotBeEmpty(t, []int{1, 2, 3}, must.WithMessage("List must have items"))
+//
+//	must.NotBeEmpty(t, &user)
+//
+// Only works with strings, slices, arrays, maps, channels, or pointers.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotBeEmpty(t testing.TB, actual any, opts ...Option) {
+	t.Helper()
+	if !assert.NotBeEmpty(t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeNil reports a test failure if the value is not nil.
+//
+// This assertion works with pointers, interfaces, channels, functions, slices, and maps.
+// It uses Go's reflection to check if the value is nil.
+//
+// Example:
+//
+//	var ptr *int
+//	must.BeNil(t, ptr)
+//
+//	var slice []int
+//	must.BeNil(t, slice, must.WithMessage("Slice should be nil"))
+//
+// Only works with nillable types (pointers, interfaces, channels, functions, slices, maps).
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeNil(t testing.TB, actual any, opts ...Option) {
+	t.Helper()
+	if !assert.BeNil(t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotBeNil reports a test failure if the value is nil.
+//
+// This assertion works with pointers, interfaces, channels, functions, slices, and maps.
+// It uses Go's reflection to check if the value is not nil.
+//
+// Example:
+//
+//	user := &User{Name: "John"}
+//	must.NotBeNil(t, user, must.WithMessage("User must not be nil"))
+//
+//	must.NotBeNil(t, make([]int, 0))
+//
+// Only works with nillable types (pointers, interfaces, channels, functions, slices, maps).
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotBeNil(t testing.TB, actual any, opts ...Option) {
+	t.Helper()
+	if !assert.NotBeNil(t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeError reports a test failure if the provided error is nil.
+//
+// This assertion is useful to ensure that a function call actually
+// produced an error when one is expected. It provides clear failure
+// messages showing when an error was expected but not returned.
+// It supports optional custom error messages through Option.
+//
+// Example:
+//
+//	must.BeError(t, err)
+//	must.BeError(t, err, must.WithMessage("Expected a validation error"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeError(t testing.TB, err error, opts ...Option) {
+	t.Helper()
+	if !assert.BeError(t, err, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotBeError - no error required
+//
+// Verifies that err is nil, ensuring successful operation.
+// Supports optional custom error messages via Option.
+//
+// Example:
+//
+//	must.NotBeError(t, err)
+//
+//	_, err = os.Open("/nonexistent/file.txt")
+//	must.NotBeError(t, err, must.WithMessage("File should exist and be readable"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotBeError(t testing.TB, err error, opts ...Option) {
+	t.Helper()
+	if !assert.NotBeError(t, err, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeErrorAs reports a test failure if the provided error does not match
+// the target type using errors.As.
+//
+// This assertion is useful when you need to verify that an error
+// can be unwrapped into a specific type, such as a custom error struct.
+// It supports optional custom error messages through Option.
+//
+// Example:
+//
+//	var pathErr *os.PathError
+//	must.BeErrorAs(t, err, &pathErr)
+//	must.BeErrorAs(t, err, &MyCustomError{}, must.WithMessage("Expected custom error type"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeErrorAs(t *testing.T, err error, target interface{}, opts ...Option) {
+	t.Helper()
+	if !assert.BeErrorAs(t, err, target, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeErrorIs reports a test failure if the provided error is not equal to
+// the target error using errors.Is.
+//
+// This assertion is useful to check if an error matches a specific sentinel
+// value, such as io.EOF or custom exported error variables.
+// It supports optional custom error messages through Option.
+//
+// Example:
+//
+//	must.BeErrorIs(t, err, io.EOF)
+//	must.BeErrorIs(t, err, ErrUnauthorized, must.WithMessage("Expected unauthorized error"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeErrorIs(t *testing.T, err error, target error, opts ...Option) {
+	t.Helper()
+	if !assert.BeErrorIs(t, err, target, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeGreaterThan reports a test failure if the value is not greater than the expected threshold.
+//
+// This assertion works with all numeric types and provides detailed
+// error messages showing the actual value, threshold, difference, and helpful hints.
+// It supports optional custom error messages through Option.
+//
+// Example:
+//
+//	must.BeGreaterThan(t, 10, 5)
+//
+//	must.BeGreaterThan(t, user.Age, 18, must.WithMessage("User must be adult"))
+//
+//	must.BeGreaterThan(t, 3.14, 2.71)
+//
+// Only works with numeric types. Both values must be of the same type.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeGreaterThan[T assert.Ordered](t testing.TB, actual T, expected T, opts ...Option) {
+	t.Helper()
+	if !assert.BeGreaterThan[T](t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeLessThan reports a test failure if the value is not less than the expected threshold.
+//
+// This assertion works with all numeric types and provides detailed
+// error messages showing the actual value, threshold, difference, and helpful hints.
+// It supports optional custom error messages through Option.
+//
+// Example:
+//
+//	must.BeLessThan(t, 5, 10)
+//
+//	must.BeLessThan(t, user.Age, 65, must.WithMessage("User must be under retirement age"))
+//
+//	must.BeLessThan(t, 2.71, 3.14)
+//
+// Only works with numeric types. Both values must be of the same type.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeLessThan[T assert.Ordered](t testing.TB, actual T, expected T, opts ...Option) {
+	t.Helper()
+	if !assert.BeLessThan[T](t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeGreaterOrEqualTo reports a test failure if the value is not greater than or equal to the expected threshold.
+//
+// This assertion works with all numeric types and provides
+// detailed error messages when the assertion fails. It supports optional custom error messages through Option.
+//
+// Example:
+//
+//	must.BeGreaterOrEqualTo(t, 10, 10)
+//
+//	must.BeGreaterOrEqualTo(t, user.Score, 0, must.WithMessage("Score cannot be negative"))
+//
+//	must.BeGreaterOrEqualTo(t, 3.14, 3.14)
+//
+// Only works with numeric types. Both values must be of the same type.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeGreaterOrEqualTo[T assert.Ordered](t testing.TB, actual T, expected T, opts ...Option) {
+	t.Helper()
+	if !assert.BeGreaterOrEqualTo[T](t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeLessOrEqualTo reports a test failure if the value is not less than or equal to the expected threshold.
+//
+// This assertion works with all numeric types and provides
+// detailed error messages when the assertion fails. It supports optional custom error messages through Option.
+//
+// Example:
+//
+//	must.BeLessOrEqualTo(t, 5, 10)
+//
+//	must.BeLessOrEqualTo(t, user.Age, 65, must.WithMessage("User must be under retirement age"))
+//
+//	must.BeLessOrEqualTo(t, 3.14, 3.14)
+//
+// Only works with numeric types. Both values must be of the same type.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeLessOrEqualTo[T assert.Ordered](t testing.TB, actual T, expected T, opts ...Option) {
+	t.Helper()
+	if !assert.BeLessOrEqualTo[T](t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeWithin reports a test failure if the actual value is not within the given tolerance of the expected value.
+//
+// This assertion works with both float32 and float64 types and provides detailed
+// error messages when the assertion fails. It is especially useful for testing
+// floating-point numbers where exact equality is unreliable due to precision issues.
+//
+// An optional custom error message can be provided using WithMessage.
+//
+// Example:
+//
+//	must.BeWithin(t, 3.14159, 3.14, 0.002)
+//
+//	must.BeWithin(t, 3.142, 3.14, 0.001, must.WithMessage("Pi approximation is outside the allowed range"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeWithin[T assert.Float](t testing.TB, actual T, expected T, tolerance T, opts ...Option) {
+	t.Helper()
+	if !assert.BeWithin[T](t, actual, expected, tolerance, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeInRange reports a test failure if the value is not within the specified range (inclusive).
+//
+// This assertion works with all numeric types and provides detailed
+// error messages when the assertion fails, indicating whether the value is
+// above or below the range and by how much.
+//
+// Example:
+//
+//	must.BeInRange(t, 25, 18, 65)
+//
+//	must.BeInRange(t, 99.5, 0.0, 100.0)
+//
+//	must.BeInRange(t, 200, 200, 299, must.WithMessage("HTTP status should be 2xx"))
+//
+// Only works with numeric types. All values must be of the same type.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeInRange[T assert.Ordered](t testing.TB, actual T, minValue T, maxValue T, opts ...Option) {
+	t.Helper()
+	if !assert.BeInRange[T](t, actual, minValue, maxValue, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeSorted reports a test failure if the slice is not sorted in ascending order.
+//
+// This assertion works with slices of any ordered type (integers, floats, strings).
+// For arrays, convert to slice using slice syntax: myArray[:].
+// Provides detailed error messages showing order violations with indices and values.
+//
+// Example:
+//
+//	must.BeSorted(t, []int{1, 2, 3, 4, 5})
+//
+//	must.BeSorted(t, []string{"a", "b", "c"})
+//
+//	must.BeSorted(t, myArray[:]) // for arrays
+//
+// Only works with slices of ordered types (cmp.Ordered constraint).
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeSorted[T assert.Sortable](t testing.TB, actual []T, opts ...Option) {
+	t.Helper()
+	if !assert.BeSorted[T](t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeEqual reports a test failure if the two values are not deeply equal.
+//
+// Uses reflect.DeepEqual for comparison. For primitive types (string, int, float, bool, etc.),
+// it shows a simple message. For complex objects (structs, slices, maps), it shows
+// field-by-field differences. Strings that span several lines, or are 280 characters
+// or longer, are shown as a unified line diff; other strings get a marker under the
+// first differing character. Byte slices and arrays, including json.RawMessage, are
+// shown as a side-by-side hexdump of the differing rows.
+//
+// Values whose type has an Equal method with the signature func(T) bool, such as
+// time.Time or net.IP, are compared with that method, wherever they appear.
+// Use WithComparer to provide the equality function for types you do not own.
+//
+// Example:
+//
+//	must.BeEqual(t, "hello", "hello")
+//
+//	must.BeEqual(t, 42, 42)
+//
+//	must.BeEqual(t, user, expectedUser, must.WithMessage("User objects should match"))
+//
+//	must.BeEqual(t, user, expectedUser, must.WithIgnoreFields("ID", "Meta.CreatedAt"))
+//
+//	must.BeEqual(t, order, expectedOrder, must.WithComparer(decimal.Decimal.Equal))
+//
+// Works with any comparable types. Uses deep comparison for complex objects.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeEqual(t testing.TB, actual any, expected any, opts ...Option) {
+	t.Helper()
+	if !assert.BeEqual(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotBeEqual reports a test failure if the two values are deeply equal.
+//
+// This assertion uses Go's reflect.DeepEqual for comparison and provides detailed
+// error messages showing exactly what differs between the values. For complex objects,
+// it shows field-by-field differences to help identify the specific mismatches.
+//
+// Example:
+//
+//	must.NotBeEqual(t, "hello", "world")
+//
+//	must.NotBeEqual(t, 42, 43)
+//
+//	must.NotBeEqual(t, user, expectedUser, must.WithMessage("User objects should not match"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotBeEqual(t testing.TB, actual any, expected any, opts ...Option) {
+	t.Helper()
+	if !assert.NotBeEqual(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// Contain reports a test failure if the slice or array does not contain the expected value.
+//
+// This assertion provides intelligent error messages based on the type of collection:
+// - For []string: Shows similar elements and typo detection
+// - For numeric slices ([]int, []float64, etc.): Shows insertion context and sorted position
+// - For other types: Shows formatted collection with clear error messages
+// Supports all slice and array types.
+//
+// Example:
+//
+//	must.Contain(t, users, "user3")
+//
+//	must.Contain(t, []int{1, 2, 3}, 2)
+//
+//	must.Contain(t, []float64{1.1, 2.2}, 1.5, must.WithMessage("Expected value missing"))
+//
+//	must.Contain(t, []string{"apple", "banana"}, "apple")
+//
+//	must.Contain(t, users, must.HasField("Age", must.GreaterOrEqualTo(18)))
+//
+// If expected is a Matcher, the collection must contain an element that satisfies it.
+// If the input is not a slice or array, the test fails immediately.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func Contain(t testing.TB, actual any, expected any, opts ...Option) {
+	t.Helper()
+	if !assert.Contain(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotContain reports a test failure if the slice or array contains the expected value.
+//
+// This assertion works with slices and arrays of any type and provides detailed
+// error messages showing where the unexpected element was found.
+//
+// Example:
+//
+//	must.NotContain(t, users, "bannedUser")
+//
+//	must.NotContain(t, []int{1, 2, 3}, 4)
+//
+//	must.NotContain(t, []string{"apple", "banana"}, "orange", must.WithMessage("Should not have orange"))
+//
+// If the input is not a slice or array, the test fails immediately.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotContain(t testing.TB, actual any, expected any, opts ...Option) {
+	t.Helper()
+	if !assert.NotContain(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// AnyMatch reports a test failure if no element in the slice matches the predicate function.
+//
+// This assertion allows custom matching logic by providing a predicate function
+// that will be called for each element in the slice. The test passes if any element
+// makes the predicate return true. To match elements against a Matcher, use AnyMatching,
+// which also explains why the closest element did not match.
+//
+// Example:
+//
+//	type User struct { Age int }
+//
+//	users := []User{{Age: 16}, {Age: 21}}
+//	must.AnyMatch(t, users, func(user User) bool {
+//		return user.Age > 18
+//	})
+//
+//	numbers := []int{1, 3, 5, 8}
+//	must.AnyMatch(t, numbers, func(n int) bool {
+//		return n%2 == 0
+//	}, must.WithMessage("No even numbers found"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func AnyMatch[T any](t testing.TB, actual []T, predicate func(T) bool, opts ...Option) {
+	t.Helper()
+	if !assert.AnyMatch[T](t, actual, predicate, opts...) {
+		t.FailNow()
+	}
+}
+
+// AnyMatching reports a test failure if no element in the slice satisfies the matcher.
+//
+// It is the Matcher counterpart of AnyMatch: the failure message shows the element that
+// came closest to matching and explains why it did not match.
+//
+// Example:
+//
+//	must.AnyMatching(t, users, must.HasField("Age", must.GreaterOrEqualTo(18)))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func AnyMatching[T any](t testing.TB, actual []T, matcher Matcher, opts ...Option) {
+	t.Helper()
+	if !assert.AnyMatching[T](t, actual, matcher, opts...) {
+		t.FailNow()
+	}
+}
+
+// StartWith reports a test failure if the string does not start with the expected substring.
+//
+// This assertion checks if the actual string starts with the expected substring.
+// It provides a detailed error message showing the expected and actual strings,
+// along with a note if the case mismatch is detected.
+//
+// Example:
+//
+//	must.StartWith(t, "Hello, world!", "hello")
+//
+//	must.StartWith(t, "Hello, world!", "hello", must.WithIgnoreCase())
+//
+//	must.StartWith(t, "Hello, world!", "world", must.WithMessage("Expected string to start with 'world'"))
+//
+// Note: The assertion is case-sensitive by default. Use must.WithIgnoreCase() to ignore case.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func StartWith(t testing.TB, actual string, expected string, opts ...Option) {
+	t.Helper()
+	if !assert.StartWith(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// EndWith reports a test failure if the string does not end with the expected substring.
+//
+// This assertion checks if the actual string ends with the expected substring.
+// It provides a detailed error message showing the expected and actual strings,
+// along with a note if the case mismatch is detected.
+//
+// Example:
+//
+//	must.EndWith(t, "Hello, world!", "world")
+//
+//	must.EndWith(t, "Hello, world", "WORLD", must.WithIgnoreCase())
+//
+//	must.EndWith(t, "Hello, world!", "world", must.WithMessage("Expected string to end with 'world'"))
+//
+// Note: The assertion is case-sensitive by default. Use must.WithIgnoreCase() to ignore case.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func EndWith(t testing.TB, actual string, expected string, opts ...Option) {
+	t.Helper()
+	if !assert.EndWith(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// ContainSubstring reports a test failure if the string does not contain the expected substring.
+//
+// This assertion checks if the actual string contains the expected substring.
+// It provides a detailed error message showing the expected and actual strings,
+// with intelligent formatting for very long strings, and includes a note if
+// case mismatch is detected. For needles up to 20 characters, it also provides
+// typo detection using Levenshtein distance to suggest similar substrings.
+//
+// Example:
+//
+//	must.ContainSubstring(t, "Hello, world!", "world")
+//
+//	must.ContainSubstring(t, "Hello, World", "WORLD", must.WithIgnoreCase())
+//
+//	must.ContainSubstring(t, longText, "keyword", must.WithMessage("Expected keyword to be present"))
+//
+// Note: The assertion is case-sensitive by default. Use must.WithIgnoreCase() to ignore case.
+// Typo detection is automatically enabled for needles up to 20 characters for performance.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func ContainSubstring(t testing.TB, actual string, substring string, opts ...Option) {
+	t.Helper()
+	if !assert.ContainSubstring(t, actual, substring, opts...) {
+		t.FailNow()
+	}
+}
+
+// MatchRegex reports a test failure if the string does not match the regular expression.
+//
+// The pattern can be a string or a *regexp.Regexp. As with regexp.MatchString, a match
+// anywhere in the string is enough; anchor the pattern with ^ and $ to match it whole.
+// On failure, the message shows the longest prefix of the pattern that matches, where in
+// the string that match stopped, and the named groups it captured, even partially.
+//
+// Example:
+//
+//	must.MatchRegex(t, id, `^usr_[a-z0-9]{12}$`)
+//
+//	must.MatchRegex(t, version, semverPattern, must.WithMessage("invalid version"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func MatchRegex(t testing.TB, actual string, pattern any, opts ...Option) {
+	t.Helper()
+	if !assert.MatchRegex(t, actual, pattern, opts...) {
+		t.FailNow()
+	}
+}
+
+// MatchRegexSubmatches reports a test failure if the string does not match the regular
+// expression, like MatchRegex, and returns the captured text.
+//
+// The result holds the text of the leftmost match followed by the text of each capture
+// group, as regexp.Regexp.FindStringSubmatch returns it. When the string does not match,
+// it holds as many empty strings, so that the test can go on without panicking; it is nil
+// only when the pattern itself is invalid.
+//
+// Example:
+//
+//	groups := must.MatchRegexSubmatches(t, location, `^/orders/(\d+)$`)
+//	must.BeEqual(t, groups[1], "42")
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func MatchRegexSubmatches(t testing.TB, actual string, pattern any, opts ...Option) []string {
+	t.Helper()
+	result, ok := assert.MatchRegexSubmatches(t, actual, pattern, opts...)
+	if !ok {
+		t.FailNow()
+	}
+	return result
+}
+
+// NotMatchRegex reports a test failure if the string matches the regular expression.
+//
+// The pattern can be a string or a *regexp.Regexp, and a match anywhere in the string
+// counts. On failure, the message shows the first match and its position.
+//
+// Example:
+//
+//	must.NotMatchRegex(t, logLine, `(?i)password=\S+`)
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotMatchRegex(t testing.TB, actual string, pattern any, opts ...Option) {
+	t.Helper()
+	if !assert.NotMatchRegex(t, actual, pattern, opts...) {
+		t.FailNow()
+	}
+}
+
+// Panic asserts that the given function panics when executed.
+// If the function does not panic, the test will fail with a descriptive error message.
+//
+// Example:
+//
+//	must.Panic(t, func() {
+//		panic("expected panic")
+//	})
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func Panic(t testing.TB, fn func(), opts ...Option) {
+	t.Helper()
+	if !assert.Panic(t, fn, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotPanic asserts that the given function does not panic when executed.
+// If the function panics, the test will fail with details about the panic.
+//
+// Example:
+//
+//	must.NotPanic(t, func() {
+//		result := safeOperation()
+//		_ = result
+//	})
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotPanic(t testing.TB, fn func(), opts ...Option) {
+	t.Helper()
+	if !assert.NotPanic(t, fn, opts...) {
+		t.FailNow()
+	}
+}
+
+// Eventually reports a test failure if the assertions in fn do not pass within the timeout.
+//
+// fn is run repeatedly, waiting interval between attempts, until an attempt passes or
+// the timeout elapses. Assertions inside fn must use the provided c instead of t. On
+// timeout, the failure captured on the last completed attempt is reported along with the
+// number of attempts made and the time they took.
+//
+// Each attempt runs in its own goroutine, so an attempt that blocks does not hold up the
+// timeout: it is abandoned, still running, once the timeout has elapsed.
+//
+// Example:
+//
+//	must.Eventually(t, func(c testing.TB) {
+//		must.BeEqual(c, job.Status(), "done")
+//	}, 2*time.Second, 50*time.Millisecond)
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func Eventually(t testing.TB, fn func(c testing.TB), timeout time.Duration, interval time.Duration, opts ...Option) {
+	t.Helper()
+	if !assert.Eventually(t, fn, timeout, interval, opts...) {
+		t.FailNow()
+	}
+}
+
+// Consistently reports a test failure if the assertions in fn stop passing at any point
+// during the given duration.
+//
+// fn is run repeatedly, waiting interval between attempts, for the whole duration.
+// Assertions inside fn must use the provided c instead of t. The first failing attempt
+// ends the check and its failure is reported along with the number of attempts made.
+//
+// Each attempt runs in its own goroutine, as in Eventually. An attempt that is still
+// running when the duration elapses is abandoned and reported as a failure.
+//
+// Example:
+//
+//	must.Consistently(t, func(c testing.TB) {
+//		must.BeEqual(c, cache.Len(), 10)
+//	}, time.Second, 100*time.Millisecond)
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func Consistently(t testing.TB, fn func(c testing.TB), duration time.Duration, interval time.Duration, opts ...Option) {
+	t.Helper()
+	if !assert.Consistently(t, fn, duration, interval, opts...) {
+		t.FailNow()
+	}
+}
+
+// MatchJSON reports a test failure if two JSON documents are not structurally equal.
+//
+// Both documents can be given as a string, as a []byte such as json.RawMessage, or as any
+// other value, which is marshaled to JSON first. Key order and whitespace are ignored and
+// numbers are compared by value, so 1 and 1.0 are equal. Differences are reported as JSON
+// paths, such as "$.items[2].price: 10 ≠ 12".
+//
+// Example:
+//
+//	must.MatchJSON(t, resp.Body, `{"id": 1, "tags": ["a", "b"]}`)
+//
+//	must.MatchJSON(t, resp.Body, expectedUser, must.WithIgnoreExtraFields())
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func MatchJSON(t testing.TB, actual any, expected any, opts ...Option) {
+	t.Helper()
+	if !assert.MatchJSON(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// ContainJSON reports a test failure if the actual JSON document does not contain the
+// shape of the expected, partial one.
+//
+// Every key of an expected object must be present in actual with a matching value, at any
+// depth; keys that are only present in actual are ignored. Arrays must have the same
+// elements in the same order, unless WithIgnoreOrder or WithArrayPrefix says otherwise.
+// The strings "<any>", "<string>", "<number>", "<bool>", "<null>", "<object>" and
+// "<array>" in the expected document match any actual value of that kind. Documents are
+// accepted in the same forms as in MatchJSON, and missing or differing values are
+// reported as JSON paths.
+//
+// Example:
+//
+//	must.ContainJSON(t, resp.Body, `{"id": "<string>", "status": "active"}`)
+//
+//	must.ContainJSON(t, resp.Body, `{"tags": ["admin"]}`, must.WithArrayPrefix())
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func ContainJSON(t testing.TB, actual any, expected any, opts ...Option) {
+	t.Helper()
+	if !assert.ContainJSON(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// MatchSnapshot reports a test failure if the value does not match the snapshot stored
+// in testdata/snapshots, in a file named after the test.
+//
+// For the subtest "admin" of TestUser, the file is testdata/snapshots/TestUser/admin.golden;
+// further calls in the same test use admin_2.golden, admin_3.golden and so on. Strings and
+// byte slices are stored as they are and other values as indented JSON, with sorted map
+// keys. A mismatch is shown as a line diff. Run the tests with SHOULD_UPDATE=1 in the
+// environment to write the snapshot files instead of checking them. This package does not
+// register any flag, but an -update flag defined by the test package, as in
+// flag.Bool("update", false, "..."), is honoured too.
+//
+// Example:
+//
+//	must.MatchSnapshot(t, renderInvoice(order))
+//
+//	must.MatchSnapshot(t, user, must.WithMessage("user payload changed"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func MatchSnapshot(t testing.TB, actual any, opts ...Option) {
+	t.Helper()
+	if !assert.MatchSnapshot(t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// MatchInlineSnapshot reports a test failure if the value does not match the snapshot
+// given inline, as a string literal in the test source.
+//
+// The value is serialized as in MatchSnapshot. When the tests run with SHOULD_UPDATE=1 in
+// the environment, or with an -update flag defined by the test package, the literal of
+// each mismatching call is rewritten with the new value and the test file is formatted
+// with gofmt. Values spanning several lines are written as raw string literals.
+//
+// Example:
+//
+//	must.MatchInlineSnapshot(t, user.DisplayName(), "Ann Smith (admin)")
+//
+//	must.MatchInlineSnapshot(t, user, `{
+//	  "name": "Ann"
+//	}`)
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func MatchInlineSnapshot(t testing.TB, actual any, expected string, opts ...Option) {
+	t.Helper()
+	if !assert.MatchInlineSnapshot(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// HaveLength reports a test failure if the collection does not have the expected length.
+//
+// This assertion works with strings, slices, arrays, and maps.
+// It provides a detailed error message showing the expected and actual lengths,
+// along with the difference.
+//
+// Example:
+//
+//	must.HaveLength(t, []int{1, 2, 3}, 3)
+//	must.HaveLength(t, "hello", 5)
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func HaveLength(t testing.TB, actual any, expected int, opts ...Option) {
+	t.Helper()
+	if !assert.HaveLength(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeSameTime reports a test failure if two `time.Time` values do not represent the same time.
+//
+// By default, the comparison is timezone-sensitive and nanosecond-precise. You can customize
+// the behavior with functional options:
+//
+// - must.WithIgnoreTimezone(): compares the instants regardless of the timezone/location
+//
+// - must.WithTruncate(unit): truncates both times to the specified precision before comparison
+//
+// Example:
+//
+//	must.BeSameTime(t, time1, time2)
+//
+//	must.BeSameTime(
+//	    t,
+//	    actual,
+//	    expected,
+//	    must.WithIgnoreTimezone(),
+//	    must.WithTruncate(time.Second),
+//	)
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeSameTime(t testing.TB, actual time.Time, expected time.Time, opts ...Option) {
+	t.Helper()
+	if !assert.BeSameTime(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeOfType reports a test failure if the value is not of the expected type.
+//
+// This assertion checks if the type of the actual value matches the type
+// of the expected value (using an instance of the expected type).
+//
+// Example:
+//
+//	type MyType struct{}
+//	var v MyType
+//	must.BeOfType(t, MyType{}, v)
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeOfType(t testing.TB, actual, expected any, opts ...Option) {
+	t.Helper()
+	if !assert.BeOfType(t, actual, expected, opts...) {
+		t.FailNow()
+	}
+}
+
+// BeOneOf reports a test failure if the value is not one of the provided options.
+//
+// This assertion checks if the actual value is present in the slice of allowed options.
+// It uses deep comparison to check for equality. When the options are of an interface
+// type, they may also contain matchers, which are checked with Match instead.
+//
+// Example:
+//
+//	status := "pending"
+//	allowedStatus := []string{"active", "inactive"}
+//	must.BeOneOf(t, status, allowedStatus)
+//
+//	must.BeOneOf[any](t, age, []any{0, must.GreaterOrEqualTo(18)})
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func BeOneOf[T any](t testing.TB, actual T, options []T, opts ...Option) {
+	t.Helper()
+	if !assert.BeOneOf[T](t, actual, options, opts...) {
+		t.FailNow()
+	}
+}
+
+// ContainKey reports a test failure if the map does not contain the expected key.
+//
+// This assertion works with maps of any key type and provides intelligent error messages:
+// - For string keys: Shows similar keys and typo detection
+// - For numeric keys: Shows similar keys with numeric differences
+// - For other types: Shows formatted keys with clear error messages
+// Supports all map types.
+//
+// Example:
+//
+//	userMap := map[string]int{"name": 1, "age": 2}
+//	must.ContainKey(t, userMap, "email")
+//
+//	must.ContainKey(t, map[int]string{1: "one", 2: "two"}, 3, must.WithMessage("Key must exist"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func ContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K, opts ...Option) {
+	t.Helper()
+	if !assert.ContainKey[K, V](t, actual, expectedKey, opts...) {
+		t.FailNow()
+	}
+}
+
+// ContainValue reports a test failure if the map does not contain the expected value.
+//
+// This assertion works with maps of any value type and provides intelligent error messages:
+// - For string values: Shows similar values and typo detection
+// - For numeric values: Shows similar values with numeric differences
+// - For other types: Shows formatted values with clear error messages
+// Supports all map types. To match the values against a Matcher, use ContainValueMatching.
+//
+// Example:
+//
+//	userMap := map[string]int{"name": 1, "age": 2}
+//	must.ContainValue(t, userMap, 3)
+//
+//	must.ContainValue(t, map[int]string{1: "one", 2: "two"}, "three", must.WithMessage("Value must exist"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func ContainValue[K comparable, V any](t testing.TB, actual map[K]V, expectedValue V, opts ...Option) {
+	t.Helper()
+	if !assert.ContainValue[K, V](t, actual, expectedValue, opts...) {
+		t.FailNow()
+	}
+}
+
+// ContainValueMatching reports a test failure if no value of the map satisfies the matcher.
+//
+// The failure message shows the value that came closest to matching and explains why
+// it did not match.
+//
+// Example:
+//
+//	must.ContainValueMatching(t, ages, must.GreaterOrEqualTo(18))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func ContainValueMatching[K comparable, V any](t testing.TB, actual map[K]V, matcher Matcher, opts ...Option) {
+	t.Helper()
+	if !assert.ContainValueMatching[K, V](t, actual, matcher, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotContainDuplicates reports a test failure if the slice or array contains duplicate values.
+//
+// This assertion works with slices and arrays of any type and provides detailed
+// error messages showing where the duplicate values were found.
+//
+// Example:
+//
+//	must.NotContainDuplicates(t, []int{1, 2, 2, 3, 3, 3, 4, 4, 4, 4, 4, 4})
+//
+//	must.NotContainDuplicates(t, []string{"John", "John"})
+//
+// If the input is not a slice or array, the test fails immediately.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotContainDuplicates(t testing.TB, actual any, opts ...Option) {
+	t.Helper()
+	if !assert.NotContainDuplicates(t, actual, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotContainKey reports a test failure if the map contains the expected key.
+//
+// This assertion works with maps of any key type and provides detailed error messages
+// showing where the key was found, including the map type, size, and context around
+// the found key. Supports all map types.
+//
+// Example:
+//
+//	userMap := map[string]int{"name": 1, "age": 2}
+//	must.NotContainKey(t, userMap, "age") // This will fail
+//
+//	must.NotContainKey(t, map[int]string{1: "one", 2: "two"}, 3, must.WithMessage("Key should not exist"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K, opts ...Option) {
+	t.Helper()
+	if !assert.NotContainKey[K, V](t, actual, expectedKey, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotContainValue reports a test failure if the map contains the expected value.
+//
+// This assertion works with maps of any value type and provides detailed error messages
+// showing where the value was found, including the map type, size, and context around
+// the found value. Supports all map types.
+//
+// Example:
+//
+//	userMap := map[string]int{"name": 1, "age": 2}
+//	must.NotContainValue(t, userMap, 2) // This will fail
+//
+//	must.NotContainValue(t, map[int]string{1: "one", 2: "two"}, "three", must.WithMessage("Value should not exist"))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotContainValue[K comparable, V any](t testing.TB, actual map[K]V, expectedValue V, opts ...Option) {
+	t.Helper()
+	if !assert.NotContainValue[K, V](t, actual, expectedValue, opts...) {
+		t.FailNow()
+	}
+}
+
+// NotContainValueMatching reports a test failure if any value of the map satisfies the
+// matcher.
+//
+// The failure message lists the values that matched.
+//
+// Example:
+//
+//	must.NotContainValueMatching(t, ages, must.LessThan(0))
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func NotContainValueMatching[K comparable, V any](t testing.TB, actual map[K]V, matcher Matcher, opts ...Option) {
+	t.Helper()
+	if !assert.NotContainValueMatching[K, V](t, actual, matcher, opts...) {
+		t.FailNow()
+	}
+}
+
+// Group runs a block of assertions and reports all of their failures at once.
+//
+// Assertions inside the block receive the recorder g instead of t. When the block
+// finishes, every recorded failure is reported as a single numbered summary that
+// shows the source line of each failed check. Use WithMaxFailures to stop the group
+// after a given number of failures.
+//
+// Example:
+//
+//	must.Group(t, func(g *must.G) {
+//		must.BeEqual(g, order.Status, "paid")
+//		must.BeGreaterThan(g, order.Total, 0)
+//		must.NotBeEmpty(g, order.Items)
+//	})
+//
+// The recorder must only be used from the goroutine running the block.
+//
+// On failure, the test is stopped immediately via t.FailNow.
+func Group(t testing.TB, fn func(g *G), opts ...Option) {
+	t.Helper()
+	if !assert.Group(t, fn, opts...) {
+		t.FailNow()
+	}
+}
+
+// Equal returns a matcher that accepts values deeply equal to expected.
+//
+// Example:
+//
+//	must.Contain(t, users, must.HasField("Name", must.Equal("John")))
+func Equal(expected any) Matcher {
+	return assert.Equal(expected)
+}
+
+// GreaterThan returns a matcher that accepts numbers greater than threshold.
+//
+// Example:
+//
+//	must.Contain(t, scores, must.GreaterThan(90))
+func GreaterThan(threshold any) Matcher {
+	return assert.GreaterThan(threshold)
+}
+
+// GreaterOrEqualTo returns a matcher that accepts numbers greater than or equal to threshold.
+//
+// Example:
+//
+//	must.Contain(t, users, must.HasField("Age", must.GreaterOrEqualTo(18)))
+func GreaterOrEqualTo(threshold any) Matcher {
+	return assert.GreaterOrEqualTo(threshold)
+}
+
+// LessThan returns a matcher that accepts numbers less than threshold.
+//
+// Example:
+//
+//	must.ContainValue(t, latencies, must.LessThan(100))
+func LessThan(threshold any) Matcher {
+	return assert.LessThan(threshold)
+}
+
+// LessOrEqualTo returns a matcher that accepts numbers less than or equal to threshold.
+//
+// Example:
+//
+//	must.Contain(t, retries, must.LessOrEqualTo(3))
+func LessOrEqualTo(threshold any) Matcher {
+	return assert.LessOrEqualTo(threshold)
+}
+
+// WithinTolerance returns a matcher that accepts numbers within tolerance of expected.
+//
+// Example:
+//
+//	must.Contain(t, readings, must.WithinTolerance(20.0, 0.5))
+func WithinTolerance(expected float64, tolerance float64) Matcher {
+	return assert.WithinTolerance(expected, tolerance)
+}
+
+// AllOf returns a matcher that accepts values accepted by every given matcher.
+//
+// Example:
+//
+//	must.Contain(t, users, must.AllOf(
+//		must.HasField("Age", must.GreaterOrEqualTo(18)),
+//		must.HasField("Active", true),
+//	))
+func AllOf(matchers ...Matcher) Matcher {
+	return assert.AllOf(matchers...)
+}
+
+// AnyOf returns a matcher that accepts values accepted by at least one of the given matchers.
+//
+// Example:
+//
+//	must.Contain(t, codes, must.AnyOf(must.Equal(200), must.Equal(204)))
+func AnyOf(matchers ...Matcher) Matcher {
+	return assert.AnyOf(matchers...)
+}
+
+// Not returns a matcher that accepts values rejected by the given matcher.
+//
+// Example:
+//
+//	must.Contain(t, users, must.HasField("Role", must.Not(must.Equal("guest"))))
+func Not(matcher Matcher) Matcher {
+	return assert.Not(matcher)
+}
+
+// HasField returns a matcher that accepts structs whose field at path satisfies expected.
+//
+// The path may refer to nested fields using dots, such as "Address.City", and pointers
+// are followed automatically. If expected is not a Matcher, the field must be deeply equal to it.
+//
+// Example:
+//
+//	must.Contain(t, users, must.HasField("Age", must.GreaterOrEqualTo(18)))
+//
+//	must.Contain(t, orders, must.HasField("Customer.Country", "BR"))
+func HasField(path string, expected any) Matcher {
+	return assert.HasField(path, expected)
+}
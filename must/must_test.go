@@ -0,0 +1,170 @@
+package must_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Kairum-Labs/should"
+	"github.com/Kairum-Labs/should/internal/mustgen"
+	"github.com/Kairum-Labs/should/must"
+)
+
+var errFailNow = errors.New("FailNow called")
+
+type mockTB struct {
+	testing.TB
+	failed      bool
+	stopped     bool
+	lastMessage string
+}
+
+func (m *mockTB) Helper() {}
+
+func (m *mockTB) Errorf(format string, args ...any) {
+	m.failed = true
+	m.lastMessage = fmt.Sprintf(format, args...)
+}
+
+func (m *mockTB) Error(args ...any) {
+	m.failed = true
+	m.lastMessage = fmt.Sprint(args...)
+}
+
+func (m *mockTB) FailNow() {
+	m.failed = true
+	m.stopped = true
+	panic(errFailNow)
+}
+
+// run executes fn the way the testing package runs a test body, treating
+// FailNow as the end of the test.
+func run(m *mockTB, fn func(t testing.TB)) (reachedEnd bool) {
+	defer func() {
+		if r := recover(); r != nil && r != errFailNow {
+			panic(r)
+		}
+	}()
+	fn(m)
+	return true
+}
+
+func TestGeneratedCodeIsUpToDate(t *testing.T) {
+	t.Parallel()
+
+	src, err := os.ReadFile("../should.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := mustgen.Generate(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("must.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("must.go is out of date; run `go generate ./must`")
+	}
+}
+
+func TestMust_StopsOnFirstFailure(t *testing.T) {
+	t.Parallel()
+
+	mockT := &mockTB{}
+	reachedEnd := run(mockT, func(t testing.TB) {
+		must.BeEqual(t, 1, 2)
+		must.BeTrue(t, false)
+	})
+
+	if reachedEnd {
+		t.Error("Expected the test body to stop after the first failure")
+	}
+	if !mockT.stopped {
+		t.Error("Expected FailNow to be called")
+	}
+	if mockT.lastMessage == "" {
+		t.Error("Expected the failure to be reported before stopping")
+	}
+}
+
+func TestMust_ContinuesWhenPassing(t *testing.T) {
+	t.Parallel()
+
+	mockT := &mockTB{}
+	reachedEnd := run(mockT, func(t testing.TB) {
+		must.BeEqual(t, 1, 1)
+		must.Contain(t, []int{1, 2, 3}, 2)
+		must.NotBeError(t, nil)
+		must.BeGreaterThan(t, 2, 1)
+		must.ContainKey(t, map[string]int{"a": 1}, "a")
+	})
+
+	if !reachedEnd {
+		t.Error("Expected the test body to run to the end")
+	}
+	if mockT.failed {
+		t.Errorf("Expected assertions to pass, but failed with message: %q", mockT.lastMessage)
+	}
+}
+
+func TestMust_ReportsSameMessageAsShould(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	testCases := []struct {
+		name       string
+		mustCall   func(t testing.TB)
+		shouldCall func(t testing.TB)
+	}{
+		{
+			name:       "BeEqual",
+			mustCall:   func(t testing.TB) { must.BeEqual(t, user{"John", 30}, user{"Jane", 31}) },
+			shouldCall: func(t testing.TB) { should.BeEqual(t, user{"John", 30}, user{"Jane", 31}) },
+		},
+		{
+			name:       "Contain",
+			mustCall:   func(t testing.TB) { must.Contain(t, []string{"apple"}, "aple", must.WithMessage("fruit")) },
+			shouldCall: func(t testing.TB) { should.Contain(t, []string{"apple"}, "aple", should.WithMessage("fruit")) },
+		},
+		{
+			name:       "StartWith",
+			mustCall:   func(t testing.TB) { must.StartWith(t, "Hello", "hex", must.WithIgnoreCase()) },
+			shouldCall: func(t testing.TB) { should.StartWith(t, "Hello", "hex", should.WithIgnoreCase()) },
+		},
+		{
+			name:       "BeInRange",
+			mustCall:   func(t testing.TB) { must.BeInRange(t, 120, 0, 100) },
+			shouldCall: func(t testing.TB) { should.BeInRange(t, 120, 0, 100) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mustT := &mockTB{}
+			run(mustT, tc.mustCall)
+			shouldT := &mockTB{}
+			run(shouldT, tc.shouldCall)
+
+			if !mustT.stopped {
+				t.Error("Expected must assertion to stop the test")
+			}
+			if shouldT.stopped {
+				t.Error("Expected should assertion not to stop the test")
+			}
+			if mustT.lastMessage != shouldT.lastMessage {
+				t.Errorf("Messages differ:\nmust  : %q\nshould: %q", mustT.lastMessage, shouldT.lastMessage)
+			}
+		})
+	}
+}
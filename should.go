@@ -16,6 +16,7 @@
 package should
 
 import (
+	"regexp"
 	"testing"
 	"time"
 
@@ -25,6 +26,18 @@ import (
 // Option is a functional option for configuring assertions.
 type Option = assert.Option
 
+// Matcher describes a condition that a value can satisfy.
+//
+// Matchers can be passed to Contain, ContainValue, NotContainValue and BeOneOf in place
+// of an expected value. When nothing matches, the failure message explains why the closest
+// element was rejected. Implement this interface to write custom matchers.
+type Matcher = assert.Matcher
+
+// G collects the failures of the assertions run inside a Group.
+//
+// It implements testing.TB and can be passed to any assertion in place of t.
+type G = assert.G
+
 // WithMessage creates an option for setting a custom error message.
 //
 // The message is treated as a plain string literal. Use this when you
@@ -103,6 +116,158 @@ func WithTruncate(unit time.Duration) Option {
 	return assert.WithTruncate(unit)
 }
 
+// WithIgnoreFields excludes the given field paths from BeEqual and NotBeEqual comparisons.
+//
+// Paths use the same dotted syntax as the "Field differences" report. Use "[*]" to
+// match any slice index or map key. Ignoring a field also ignores everything below it.
+//
+// Example:
+//
+//	should.BeEqual(t, actual, expected, should.WithIgnoreFields("ID", "Meta.CreatedAt", "Items[*].UpdatedAt"))
+func WithIgnoreFields(paths ...string) Option {
+	return assert.WithIgnoreFields(paths...)
+}
+
+// WithUnexportedFields makes BeEqual report differences in unexported struct fields.
+//
+// Unexported fields always take part in the comparison itself. By default, a failure
+// caused only by them just names the fields; with this option, their values are diffed
+// like exported ones.
+//
+// Example:
+//
+//	should.BeEqual(t, actual, expected, should.WithUnexportedFields())
+func WithUnexportedFields() Option {
+	return assert.WithUnexportedFields()
+}
+
+// WithIgnoreOrder makes BeEqual and NotBeEqual compare slices regardless of element order.
+//
+// Without arguments, every slice and array is compared as a multiset, at any depth.
+// Pass paths, using the same syntax as WithIgnoreFields, to limit it to those slices.
+// On failure, the report lists the elements that are missing and the unexpected ones.
+// MatchJSON and ContainJSON apply it to JSON arrays.
+//
+// Example:
+//
+//	should.BeEqual(t, users, expectedUsers, should.WithIgnoreOrder())
+//
+//	should.BeEqual(t, order, expectedOrder, should.WithIgnoreOrder("Items", "Items[*].Tags"))
+func WithIgnoreOrder(paths ...string) Option {
+	return assert.WithIgnoreOrder(paths...)
+}
+
+// WithComparer makes BeEqual and NotBeEqual compare values of type T with the given function.
+//
+// The comparer applies wherever a T appears, including struct fields, slice elements
+// and map values, and takes precedence over the type's own Equal method.
+//
+// Example:
+//
+//	should.BeEqual(t, got, want, should.WithComparer(func(a, b float64) bool {
+//		return math.Abs(a-b) < 1e-9
+//	}))
+func WithComparer[T any](equal func(a, b T) bool) Option {
+	return assert.WithComparer(equal)
+}
+
+// WithGoLiteral makes BeEqual failures also show the actual value as gofmt-ed Go source,
+// ready to be pasted into the test as the new expected value.
+//
+// Type names are qualified with their package name, pointers to basic values are built
+// with a small helper func, times are written as time.Date calls and errors as
+// errors.New calls. A value that refers back to itself, or that holds a value whose type
+// cannot be named outside of its package, has no literal form, so the message says why
+// instead.
+//
+// Example:
+//
+//	should.BeEqual(t, order, expectedOrder, should.WithGoLiteral())
+func WithGoLiteral() Option {
+	return assert.WithGoLiteral()
+}
+
+// WithIgnoreExtraFields makes MatchJSON ignore object keys that are only present in the
+// actual document, at any depth.
+//
+// Example:
+//
+//	should.MatchJSON(t, resp.Body, `{"id": 1}`, should.WithIgnoreExtraFields())
+func WithIgnoreExtraFields() Option {
+	return assert.WithIgnoreExtraFields()
+}
+
+// WithArrayPrefix makes MatchJSON and ContainJSON accept actual arrays that start with the
+// expected elements and continue with more.
+//
+// Without arguments, it applies to every array, at any depth. Pass paths, using the same
+// syntax as WithIgnoreFields, to limit it to those arrays.
+//
+// Example:
+//
+//	should.ContainJSON(t, resp.Body, `{"events": [{"type": "created"}]}`, should.WithArrayPrefix("events"))
+func WithArrayPrefix(paths ...string) Option {
+	return assert.WithArrayPrefix(paths...)
+}
+
+// WithScrubber replaces every match of pattern with replacement before strings are compared.
+//
+// It applies to BeEqual on strings, ContainSubstring, MatchSnapshot and MatchInlineSnapshot,
+// so that volatile content such as IDs, ports or durations does not break them. The
+// replacement may refer to submatches, as in regexp.Regexp.ReplaceAllString, and failure
+// messages show the scrubbed strings. Scrubbers run in the order they are given.
+//
+// Example:
+//
+//	port := regexp.MustCompile(`:\d{2,5}\b`)
+//	should.ContainSubstring(t, logs, "listening on 127.0.0.1:<PORT>", should.WithScrubber(port, ":<PORT>"))
+func WithScrubber(pattern *regexp.Regexp, replacement string) Option {
+	return assert.WithScrubber(pattern, replacement)
+}
+
+// WithScrubUUIDs is a scrubber that replaces UUIDs with "<UUID>".
+//
+// Example:
+//
+//	should.BeEqual(t, receipt, "order <UUID> paid", should.WithScrubUUIDs())
+func WithScrubUUIDs() Option {
+	return assert.WithScrubUUIDs()
+}
+
+// WithScrubTimestamps is a scrubber that replaces RFC 3339 timestamps, and times formatted
+// by time.Time.String, with "<TIMESTAMP>".
+//
+// Example:
+//
+//	should.MatchSnapshot(t, event, should.WithScrubTimestamps())
+func WithScrubTimestamps() Option {
+	return assert.WithScrubTimestamps()
+}
+
+// WithScrubTempDir is a scrubber that replaces the temporary directory, along with the
+// directory created in it by t.TempDir or os.MkdirTemp, with "<TMPDIR>".
+//
+// Example:
+//
+//	should.BeEqual(t, err.Error(), "open <TMPDIR>/001/config.yaml: permission denied", should.WithScrubTempDir())
+func WithScrubTempDir() Option {
+	return assert.WithScrubTempDir()
+}
+
+// WithMaxFailures stops a Group as soon as n failures have been recorded.
+//
+// The failures collected up to that point are still reported in the summary.
+// By default, every check in the group is executed.
+//
+// Example:
+//
+//	should.Group(t, func(g *should.G) {
+//		// ...
+//	}, should.WithMaxFailures(3))
+func WithMaxFailures(n int) Option {
+	return assert.WithMaxFailures(n)
+}
+
 // BeTrue reports a test failure if the value is not true.
 //
 // This assertion only works with boolean values and will fail immediately
@@ -416,7 +581,14 @@ func BeSorted[T assert.Sortable](t testing.TB, actual []T, opts ...Option) {
 //
 // Uses reflect.DeepEqual for comparison. For primitive types (string, int, float, bool, etc.),
 // it shows a simple message. For complex objects (structs, slices, maps), it shows
-// field-by-field differences.
+// field-by-field differences. Strings that span several lines, or are 280 characters
+// or longer, are shown as a unified line diff; other strings get a marker under the
+// first differing character. Byte slices and arrays, including json.RawMessage, are
+// shown as a side-by-side hexdump of the differing rows.
+//
+// Values whose type has an Equal method with the signature func(T) bool, such as
+// time.Time or net.IP, are compared with that method, wherever they appear.
+// Use WithComparer to provide the equality function for types you do not own.
 //
 // Example:
 //
@@ -426,6 +598,10 @@ func BeSorted[T assert.Sortable](t testing.TB, actual []T, opts ...Option) {
 //
 //	should.BeEqual(t, user, expectedUser, should.WithMessage("User objects should match"))
 //
+//	should.BeEqual(t, user, expectedUser, should.WithIgnoreFields("ID", "Meta.CreatedAt"))
+//
+//	should.BeEqual(t, order, expectedOrder, should.WithComparer(decimal.Decimal.Equal))
+//
 // Works with any comparable types. Uses deep comparison for complex objects.
 func BeEqual(t testing.TB, actual any, expected any, opts ...Option) {
 	t.Helper()
@@ -468,6 +644,9 @@ func NotBeEqual(t testing.TB, actual any, expected any, opts ...Option) {
 //
 //	should.Contain(t, []string{"apple", "banana"}, "apple")
 //
+//	should.Contain(t, users, should.HasField("Age", should.GreaterOrEqualTo(18)))
+//
+// If expected is a Matcher, the collection must contain an element that satisfies it.
 // If the input is not a slice or array, the test fails immediately.
 func Contain(t testing.TB, actual any, expected any, opts ...Option) {
 	t.Helper()
@@ -497,7 +676,8 @@ func NotContain(t testing.TB, actual any, expected any, opts ...Option) {
 //
 // This assertion allows custom matching logic by providing a predicate function
 // that will be called for each element in the slice. The test passes if any element
-// makes the predicate return true.
+// makes the predicate return true. To match elements against a Matcher, use AnyMatching,
+// which also explains why the closest element did not match.
 //
 // Example:
 //
@@ -517,6 +697,19 @@ func AnyMatch[T any](t testing.TB, actual []T, predicate func(T) bool, opts ...O
 	assert.AnyMatch(t, actual, predicate, opts...)
 }
 
+// AnyMatching reports a test failure if no element in the slice satisfies the matcher.
+//
+// It is the Matcher counterpart of AnyMatch: the failure message shows the element that
+// came closest to matching and explains why it did not match.
+//
+// Example:
+//
+//	should.AnyMatching(t, users, should.HasField("Age", should.GreaterOrEqualTo(18)))
+func AnyMatching[T any](t testing.TB, actual []T, matcher Matcher, opts ...Option) {
+	t.Helper()
+	assert.AnyMatching(t, actual, matcher, opts...)
+}
+
 // StartWith reports a test failure if the string does not start with the expected substring.
 //
 // This assertion checks if the actual string starts with the expected substring.
@@ -580,6 +773,54 @@ func ContainSubstring(t testing.TB, actual string, substring string, opts ...Opt
 	assert.ContainSubstring(t, actual, substring, opts...)
 }
 
+// MatchRegex reports a test failure if the string does not match the regular expression.
+//
+// The pattern can be a string or a *regexp.Regexp. As with regexp.MatchString, a match
+// anywhere in the string is enough; anchor the pattern with ^ and $ to match it whole.
+// On failure, the message shows the longest prefix of the pattern that matches, where in
+// the string that match stopped, and the named groups it captured, even partially.
+//
+// Example:
+//
+//	should.MatchRegex(t, id, `^usr_[a-z0-9]{12}$`)
+//
+//	should.MatchRegex(t, version, semverPattern, should.WithMessage("invalid version"))
+func MatchRegex(t testing.TB, actual string, pattern any, opts ...Option) {
+	t.Helper()
+	assert.MatchRegex(t, actual, pattern, opts...)
+}
+
+// MatchRegexSubmatches reports a test failure if the string does not match the regular
+// expression, like MatchRegex, and returns the captured text.
+//
+// The result holds the text of the leftmost match followed by the text of each capture
+// group, as regexp.Regexp.FindStringSubmatch returns it. When the string does not match,
+// it holds as many empty strings, so that the test can go on without panicking; it is nil
+// only when the pattern itself is invalid.
+//
+// Example:
+//
+//	groups := should.MatchRegexSubmatches(t, location, `^/orders/(\d+)$`)
+//	should.BeEqual(t, groups[1], "42")
+func MatchRegexSubmatches(t testing.TB, actual string, pattern any, opts ...Option) []string {
+	t.Helper()
+	submatches, _ := assert.MatchRegexSubmatches(t, actual, pattern, opts...)
+	return submatches
+}
+
+// NotMatchRegex reports a test failure if the string matches the regular expression.
+//
+// The pattern can be a string or a *regexp.Regexp, and a match anywhere in the string
+// counts. On failure, the message shows the first match and its position.
+//
+// Example:
+//
+//	should.NotMatchRegex(t, logLine, `(?i)password=\S+`)
+func NotMatchRegex(t testing.TB, actual string, pattern any, opts ...Option) {
+	t.Helper()
+	assert.NotMatchRegex(t, actual, pattern, opts...)
+}
+
 // Panic asserts that the given function panics when executed.
 // If the function does not panic, the test will fail with a descriptive error message.
 //
@@ -607,6 +848,142 @@ func NotPanic(t testing.TB, fn func(), opts ...Option) {
 	assert.NotPanic(t, fn, opts...)
 }
 
+// Eventually reports a test failure if the assertions in fn do not pass within the timeout.
+//
+// fn is run repeatedly, waiting interval between attempts, until an attempt passes or
+// the timeout elapses. Assertions inside fn must use the provided c instead of t. On
+// timeout, the failure captured on the last completed attempt is reported along with the
+// number of attempts made and the time they took.
+//
+// Each attempt runs in its own goroutine, so an attempt that blocks does not hold up the
+// timeout: it is abandoned, still running, once the timeout has elapsed.
+//
+// Example:
+//
+//	should.Eventually(t, func(c testing.TB) {
+//		should.BeEqual(c, job.Status(), "done")
+//	}, 2*time.Second, 50*time.Millisecond)
+func Eventually(t testing.TB, fn func(c testing.TB), timeout time.Duration, interval time.Duration, opts ...Option) {
+	t.Helper()
+	assert.Eventually(t, fn, timeout, interval, opts...)
+}
+
+// Consistently reports a test failure if the assertions in fn stop passing at any point
+// during the given duration.
+//
+// fn is run repeatedly, waiting interval between attempts, for the whole duration.
+// Assertions inside fn must use the provided c instead of t. The first failing attempt
+// ends the check and its failure is reported along with the number of attempts made.
+//
+// Each attempt runs in its own goroutine, as in Eventually. An attempt that is still
+// running when the duration elapses is abandoned and reported as a failure.
+//
+// Example:
+//
+//	should.Consistently(t, func(c testing.TB) {
+//		should.BeEqual(c, cache.Len(), 10)
+//	}, time.Second, 100*time.Millisecond)
+func Consistently(t testing.TB, fn func(c testing.TB), duration time.Duration, interval time.Duration, opts ...Option) {
+	t.Helper()
+	assert.Consistently(t, fn, duration, interval, opts...)
+}
+
+// MatchJSON reports a test failure if two JSON documents are not structurally equal.
+//
+// Both documents can be given as a string, as a []byte such as json.RawMessage, or as any
+// other value, which is marshaled to JSON first. Key order and whitespace are ignored and
+// numbers are compared by value, so 1 and 1.0 are equal. Differences are reported as JSON
+// paths, such as "$.items[2].price: 10 ≠ 12".
+//
+// Example:
+//
+//	should.MatchJSON(t, resp.Body, `{"id": 1, "tags": ["a", "b"]}`)
+//
+//	should.MatchJSON(t, resp.Body, expectedUser, should.WithIgnoreExtraFields())
+func MatchJSON(t testing.TB, actual any, expected any, opts ...Option) {
+	t.Helper()
+	assert.MatchJSON(t, actual, expected, opts...)
+}
+
+// ContainJSON reports a test failure if the actual JSON document does not contain the
+// shape of the expected, partial one.
+//
+// Every key of an expected object must be present in actual with a matching value, at any
+// depth; keys that are only present in actual are ignored. Arrays must have the same
+// elements in the same order, unless WithIgnoreOrder or WithArrayPrefix says otherwise.
+// The strings "<any>", "<string>", "<number>", "<bool>", "<null>", "<object>" and
+// "<array>" in the expected document match any actual value of that kind. Documents are
+// accepted in the same forms as in MatchJSON, and missing or differing values are
+// reported as JSON paths.
+//
+// Example:
+//
+//	should.ContainJSON(t, resp.Body, `{"id": "<string>", "status": "active"}`)
+//
+//	should.ContainJSON(t, resp.Body, `{"tags": ["admin"]}`, should.WithArrayPrefix())
+func ContainJSON(t testing.TB, actual any, expected any, opts ...Option) {
+	t.Helper()
+	assert.ContainJSON(t, actual, expected, opts...)
+}
+
+// MatchSnapshot reports a test failure if the value does not match the snapshot stored
+// in testdata/snapshots, in a file named after the test.
+//
+// For the subtest "admin" of TestUser, the file is testdata/snapshots/TestUser/admin.golden;
+// further calls in the same test use admin_2.golden, admin_3.golden and so on. Strings and
+// byte slices are stored as they are and other values as indented JSON, with sorted map
+// keys. A mismatch is shown as a line diff. Run the tests with SHOULD_UPDATE=1 in the
+// environment to write the snapshot files instead of checking them. This package does not
+// register any flag, but an -update flag defined by the test package, as in
+// flag.Bool("update", false, "..."), is honoured too.
+//
+// Example:
+//
+//	should.MatchSnapshot(t, renderInvoice(order))
+//
+//	should.MatchSnapshot(t, user, should.WithMessage("user payload changed"))
+func MatchSnapshot(t testing.TB, actual any, opts ...Option) {
+	t.Helper()
+	assert.MatchSnapshot(t, actual, opts...)
+}
+
+// MatchInlineSnapshot reports a test failure if the value does not match the snapshot
+// given inline, as a string literal in the test source.
+//
+// The value is serialized as in MatchSnapshot. When the tests run with SHOULD_UPDATE=1 in
+// the environment, or with an -update flag defined by the test package, the literal of
+// each mismatching call is rewritten with the new value and the test file is formatted
+// with gofmt. Values spanning several lines are written as raw string literals.
+//
+// Example:
+//
+//	should.MatchInlineSnapshot(t, user.DisplayName(), "Ann Smith (admin)")
+//
+//	should.MatchInlineSnapshot(t, user, `{
+//	  "name": "Ann"
+//	}`)
+func MatchInlineSnapshot(t testing.TB, actual any, expected string, opts ...Option) {
+	t.Helper()
+	assert.MatchInlineSnapshot(t, actual, expected, opts...)
+}
+
+// RunSnapshots runs the tests of a package, like m.Run, then reports the snapshot files
+// of MatchSnapshot that no test used.
+//
+// Orphaned snapshots make the run fail, unless snapshots are being updated with
+// SHOULD_UPDATE=1 or an -update flag defined by the test package, in which case they
+// are removed. They are only looked for after a full run, not when tests are selected
+// with -run or -skip, or skipped with -short.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(should.RunSnapshots(m))
+//	}
+func RunSnapshots(m *testing.M) int {
+	return assert.RunSnapshots(m)
+}
+
 // HaveLength reports a test failure if the collection does not have the expected length.
 //
 // This assertion works with strings, slices, arrays, and maps.
@@ -665,13 +1042,16 @@ func BeOfType(t testing.TB, actual, expected any, opts ...Option) {
 // BeOneOf reports a test failure if the value is not one of the provided options.
 //
 // This assertion checks if the actual value is present in the slice of allowed options.
-// It uses deep comparison to check for equality.
+// It uses deep comparison to check for equality. When the options are of an interface
+// type, they may also contain matchers, which are checked with Match instead.
 //
 // Example:
 //
 //	status := "pending"
 //	allowedStatus := []string{"active", "inactive"}
 //	should.BeOneOf(t, status, allowedStatus)
+//
+//	should.BeOneOf[any](t, age, []any{0, should.GreaterOrEqualTo(18)})
 func BeOneOf[T any](t testing.TB, actual T, options []T, opts ...Option) {
 	t.Helper()
 	assert.BeOneOf(t, actual, options, opts...)
@@ -702,7 +1082,7 @@ func ContainKey[K comparable, V any](t testing.TB, actual map[K]V, expectedKey K
 // - For string values: Shows similar values and typo detection
 // - For numeric values: Shows similar values with numeric differences
 // - For other types: Shows formatted values with clear error messages
-// Supports all map types.
+// Supports all map types. To match the values against a Matcher, use ContainValueMatching.
 //
 // Example:
 //
@@ -715,6 +1095,19 @@ func ContainValue[K comparable, V any](t testing.TB, actual map[K]V, expectedVal
 	assert.ContainValue(t, actual, expectedValue, opts...)
 }
 
+// ContainValueMatching reports a test failure if no value of the map satisfies the matcher.
+//
+// The failure message shows the value that came closest to matching and explains why
+// it did not match.
+//
+// Example:
+//
+//	should.ContainValueMatching(t, ages, should.GreaterOrEqualTo(18))
+func ContainValueMatching[K comparable, V any](t testing.TB, actual map[K]V, matcher Matcher, opts ...Option) {
+	t.Helper()
+	assert.ContainValueMatching(t, actual, matcher, opts...)
+}
+
 // NotContainDuplicates reports a test failure if the slice or array contains duplicate values.
 //
 // This assertion works with slices and arrays of any type and provides detailed
@@ -765,3 +1158,135 @@ func NotContainValue[K comparable, V any](t testing.TB, actual map[K]V, expected
 	t.Helper()
 	assert.NotContainValue(t, actual, expectedValue, opts...)
 }
+
+// NotContainValueMatching reports a test failure if any value of the map satisfies the
+// matcher.
+//
+// The failure message lists the values that matched.
+//
+// Example:
+//
+//	should.NotContainValueMatching(t, ages, should.LessThan(0))
+func NotContainValueMatching[K comparable, V any](t testing.TB, actual map[K]V, matcher Matcher, opts ...Option) {
+	t.Helper()
+	assert.NotContainValueMatching(t, actual, matcher, opts...)
+}
+
+// Group runs a block of assertions and reports all of their failures at once.
+//
+// Assertions inside the block receive the recorder g instead of t. When the block
+// finishes, every recorded failure is reported as a single numbered summary that
+// shows the source line of each failed check. Use WithMaxFailures to stop the group
+// after a given number of failures.
+//
+// Example:
+//
+//	should.Group(t, func(g *should.G) {
+//		should.BeEqual(g, order.Status, "paid")
+//		should.BeGreaterThan(g, order.Total, 0)
+//		should.NotBeEmpty(g, order.Items)
+//	})
+//
+// The recorder must only be used from the goroutine running the block.
+func Group(t testing.TB, fn func(g *G), opts ...Option) {
+	t.Helper()
+	assert.Group(t, fn, opts...)
+}
+
+// Equal returns a matcher that accepts values deeply equal to expected.
+//
+// Example:
+//
+//	should.Contain(t, users, should.HasField("Name", should.Equal("John")))
+func Equal(expected any) Matcher {
+	return assert.Equal(expected)
+}
+
+// GreaterThan returns a matcher that accepts numbers greater than threshold.
+//
+// Example:
+//
+//	should.Contain(t, scores, should.GreaterThan(90))
+func GreaterThan(threshold any) Matcher {
+	return assert.GreaterThan(threshold)
+}
+
+// GreaterOrEqualTo returns a matcher that accepts numbers greater than or equal to threshold.
+//
+// Example:
+//
+//	should.Contain(t, users, should.HasField("Age", should.GreaterOrEqualTo(18)))
+func GreaterOrEqualTo(threshold any) Matcher {
+	return assert.GreaterOrEqualTo(threshold)
+}
+
+// LessThan returns a matcher that accepts numbers less than threshold.
+//
+// Example:
+//
+//	should.ContainValue(t, latencies, should.LessThan(100))
+func LessThan(threshold any) Matcher {
+	return assert.LessThan(threshold)
+}
+
+// LessOrEqualTo returns a matcher that accepts numbers less than or equal to threshold.
+//
+// Example:
+//
+//	should.Contain(t, retries, should.LessOrEqualTo(3))
+func LessOrEqualTo(threshold any) Matcher {
+	return assert.LessOrEqualTo(threshold)
+}
+
+// WithinTolerance returns a matcher that accepts numbers within tolerance of expected.
+//
+// Example:
+//
+//	should.Contain(t, readings, should.WithinTolerance(20.0, 0.5))
+func WithinTolerance(expected float64, tolerance float64) Matcher {
+	return assert.WithinTolerance(expected, tolerance)
+}
+
+// AllOf returns a matcher that accepts values accepted by every given matcher.
+//
+// Example:
+//
+//	should.Contain(t, users, should.AllOf(
+//		should.HasField("Age", should.GreaterOrEqualTo(18)),
+//		should.HasField("Active", true),
+//	))
+func AllOf(matchers ...Matcher) Matcher {
+	return assert.AllOf(matchers...)
+}
+
+// AnyOf returns a matcher that accepts values accepted by at least one of the given matchers.
+//
+// Example:
+//
+//	should.Contain(t, codes, should.AnyOf(should.Equal(200), should.Equal(204)))
+func AnyOf(matchers ...Matcher) Matcher {
+	return assert.AnyOf(matchers...)
+}
+
+// Not returns a matcher that accepts values rejected by the given matcher.
+//
+// Example:
+//
+//	should.Contain(t, users, should.HasField("Role", should.Not(should.Equal("guest"))))
+func Not(matcher Matcher) Matcher {
+	return assert.Not(matcher)
+}
+
+// HasField returns a matcher that accepts structs whose field at path satisfies expected.
+//
+// The path may refer to nested fields using dots, such as "Address.City", and pointers
+// are followed automatically. If expected is not a Matcher, the field must be deeply equal to it.
+//
+// Example:
+//
+//	should.Contain(t, users, should.HasField("Age", should.GreaterOrEqualTo(18)))
+//
+//	should.Contain(t, orders, should.HasField("Customer.Country", "BR"))
+func HasField(path string, expected any) Matcher {
+	return assert.HasField(path, expected)
+}
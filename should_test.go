@@ -2,9 +2,11 @@ package should
 
 import (
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -496,6 +498,26 @@ func TestWrappers(t *testing.T) {
 		}
 	})
 
+	// Matcher variants
+	t.Run("AnyMatching passes", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		AnyMatching(mockT, []int{1, 2, 3}, GreaterThan(2))
+		ContainValueMatching(mockT, map[string]int{"a": 1}, LessThan(2))
+		NotContainValueMatching(mockT, map[string]int{"a": 1}, GreaterThan(1))
+		if mockT.failed {
+			t.Error("Matcher variants should pass")
+		}
+	})
+	t.Run("AnyMatching fails", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		AnyMatching(mockT, []int{1, 2, 3}, GreaterThan(3))
+		if !mockT.failed || !strings.Contains(mockT.lastMessage, "Because   : 3 <= 3") {
+			t.Errorf("AnyMatching should fail and explain why, got: %q", mockT.lastMessage)
+		}
+	})
+
 	t.Run("ContainSubstring passes", func(t *testing.T) {
 		t.Parallel()
 		mockT := &mockTB{}
@@ -617,3 +639,61 @@ func TestContainValue_Integration(t *testing.T) {
 	intMap := map[int]string{1: "one", 2: "two", 3: "three"}
 	ContainValue(t, intMap, "two")
 }
+
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	mockT := &mockTB{}
+	Group(mockT, func(g *G) {
+		BeEqual(g, "paid", "pending")
+		NotBeEmpty(g, []int{})
+	})
+
+	if !mockT.failed {
+		t.Fatal("Expected Group to fail")
+	}
+	for _, part := range []string{"Group recorded 2 failures:", "1) should_test.go:", "2) should_test.go:"} {
+		if !strings.Contains(mockT.lastMessage, part) {
+			t.Errorf("Expected summary to contain %q, got:\n%s", part, mockT.lastMessage)
+		}
+	}
+}
+
+func TestMatchSnapshot_UsesUpdateFlagOfTestPackage(t *testing.T) {
+	// Not parallel: the flags are shared by every test of the package. Golden file tests
+	// usually define -update for themselves, which packages importing should must be able
+	// to do; a flag set of its own stands in for the one of such a package.
+	t.Setenv("SHOULD_UPDATE", "")
+	commandLine := flag.CommandLine
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	flag.CommandLine.Bool("update", true, "update golden files")
+	t.Cleanup(func() { flag.CommandLine = commandLine })
+
+	golden := filepath.Join("testdata", "snapshots", "TestMatchSnapshot_UsesUpdateFlagOfTestPackage.golden")
+	t.Cleanup(func() {
+		os.Remove(golden)
+		// Remove the directories too, if the snapshot was all they held
+		os.Remove(filepath.Dir(golden))
+		os.Remove(filepath.Dir(filepath.Dir(golden)))
+	})
+
+	MatchSnapshot(t, "written by -update")
+
+	content, err := os.ReadFile(golden)
+	if err != nil || string(content) != "written by -update\n" {
+		t.Errorf("Expected the snapshot to be written, got %q, %v", content, err)
+	}
+}
+
+func TestMatchRegexSubmatches_FailureCanBeIndexed(t *testing.T) {
+	t.Parallel()
+
+	mockT := &mockTB{}
+	groups := MatchRegexSubmatches(mockT, "/orders/x", `^/orders/(\d+)/items/(\d+)$`)
+	if !mockT.failed {
+		t.Fatal("Expected MatchRegexSubmatches to fail")
+	}
+	if len(groups) != 3 || groups[1] != "" || groups[2] != "" {
+		t.Errorf("Expected one empty string per group, got %q", groups)
+	}
+}
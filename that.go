@@ -0,0 +1,518 @@
+package should
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Kairum-Labs/should/assert"
+)
+
+// Subject holds a value under test together with the testing.TB and the
+// options shared by every assertion chained on it.
+//
+// Subjects are created with That and its typed variants (ThatString,
+// ThatNumber, ThatSlice, ThatMap, ThatError and ThatTime). Every method
+// reports failures exactly like the equivalent free function and returns
+// the subject, so several checks on one value read as a single statement.
+type Subject struct {
+	t      testing.TB
+	actual any
+	opts   []Option
+}
+
+// That starts a fluent assertion chain for a value of any type.
+//
+// Options passed to That apply to every assertion in the chain. Options
+// passed to an individual method are applied after them.
+//
+// Example:
+//
+//	should.That(t, user).NotBeNil().BeOfType(&User{})
+//
+//	should.That(t, items, should.WithMessage("items")).NotBeEmpty().HaveLength(3)
+func That(t testing.TB, actual any, opts ...Option) *Subject {
+	return &Subject{t: t, actual: actual, opts: opts}
+}
+
+// BeEqual reports a test failure if the value is not deeply equal to expected.
+func (s *Subject) BeEqual(expected any, opts ...Option) *Subject {
+	s.t.Helper()
+	assert.BeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeEqual reports a test failure if the value is deeply equal to expected.
+func (s *Subject) NotBeEqual(expected any, opts ...Option) *Subject {
+	s.t.Helper()
+	assert.NotBeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeNil reports a test failure if the value is not nil.
+func (s *Subject) BeNil(opts ...Option) *Subject {
+	s.t.Helper()
+	assert.BeNil(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeNil reports a test failure if the value is nil.
+func (s *Subject) NotBeNil(opts ...Option) *Subject {
+	s.t.Helper()
+	assert.NotBeNil(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeEmpty reports a test failure if the value is not empty.
+func (s *Subject) BeEmpty(opts ...Option) *Subject {
+	s.t.Helper()
+	assert.BeEmpty(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeEmpty reports a test failure if the value is empty.
+func (s *Subject) NotBeEmpty(opts ...Option) *Subject {
+	s.t.Helper()
+	assert.NotBeEmpty(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// HaveLength reports a test failure if the value does not have the expected length.
+func (s *Subject) HaveLength(expected int, opts ...Option) *Subject {
+	s.t.Helper()
+	assert.HaveLength(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeOfType reports a test failure if the value is not of the same type as expected.
+func (s *Subject) BeOfType(expected any, opts ...Option) *Subject {
+	s.t.Helper()
+	assert.BeOfType(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// StringSubject is a fluent assertion chain for a string value.
+type StringSubject struct {
+	t      testing.TB
+	actual string
+	opts   []Option
+}
+
+// ThatString starts a fluent assertion chain for a string.
+//
+// Example:
+//
+//	should.ThatString(t, name).StartWith("Jo").EndWith("hn").HaveLength(4)
+func ThatString(t testing.TB, actual string, opts ...Option) *StringSubject {
+	return &StringSubject{t: t, actual: actual, opts: opts}
+}
+
+// BeEqual reports a test failure if the string is not equal to expected.
+func (s *StringSubject) BeEqual(expected string, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.BeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeEqual reports a test failure if the string is equal to expected.
+func (s *StringSubject) NotBeEqual(expected string, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.NotBeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeEmpty reports a test failure if the string is not empty.
+func (s *StringSubject) BeEmpty(opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.BeEmpty(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeEmpty reports a test failure if the string is empty.
+func (s *StringSubject) NotBeEmpty(opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.NotBeEmpty(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// HaveLength reports a test failure if the string does not have the expected length in bytes.
+func (s *StringSubject) HaveLength(expected int, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.HaveLength(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// StartWith reports a test failure if the string does not start with expected.
+func (s *StringSubject) StartWith(expected string, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.StartWith(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// EndWith reports a test failure if the string does not end with expected.
+func (s *StringSubject) EndWith(expected string, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.EndWith(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// ContainSubstring reports a test failure if the string does not contain substring.
+func (s *StringSubject) ContainSubstring(substring string, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.ContainSubstring(s.t, s.actual, substring, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// MatchRegex reports a test failure if the string does not match the regular expression.
+func (s *StringSubject) MatchRegex(pattern any, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.MatchRegex(s.t, s.actual, pattern, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotMatchRegex reports a test failure if the string matches the regular expression.
+func (s *StringSubject) NotMatchRegex(pattern any, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.NotMatchRegex(s.t, s.actual, pattern, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeOneOf reports a test failure if the string is not one of the provided options.
+func (s *StringSubject) BeOneOf(options []string, opts ...Option) *StringSubject {
+	s.t.Helper()
+	assert.BeOneOf(s.t, s.actual, options, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NumberSubject is a fluent assertion chain for a numeric value.
+type NumberSubject[T assert.Ordered] struct {
+	t      testing.TB
+	actual T
+	opts   []Option
+}
+
+// ThatNumber starts a fluent assertion chain for a numeric value.
+//
+// Example:
+//
+//	should.ThatNumber(t, user.Age).BeGreaterOrEqualTo(18).BeLessThan(65)
+func ThatNumber[T assert.Ordered](t testing.TB, actual T, opts ...Option) *NumberSubject[T] {
+	return &NumberSubject[T]{t: t, actual: actual, opts: opts}
+}
+
+// BeEqual reports a test failure if the number is not equal to expected.
+func (s *NumberSubject[T]) BeEqual(expected T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.BeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeEqual reports a test failure if the number is equal to expected.
+func (s *NumberSubject[T]) NotBeEqual(expected T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.NotBeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeGreaterThan reports a test failure if the number is not greater than expected.
+func (s *NumberSubject[T]) BeGreaterThan(expected T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.BeGreaterThan(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeLessThan reports a test failure if the number is not less than expected.
+func (s *NumberSubject[T]) BeLessThan(expected T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.BeLessThan(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeGreaterOrEqualTo reports a test failure if the number is not greater than or equal to expected.
+func (s *NumberSubject[T]) BeGreaterOrEqualTo(expected T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.BeGreaterOrEqualTo(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeLessOrEqualTo reports a test failure if the number is not less than or equal to expected.
+func (s *NumberSubject[T]) BeLessOrEqualTo(expected T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.BeLessOrEqualTo(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeInRange reports a test failure if the number is not within [minValue, maxValue].
+func (s *NumberSubject[T]) BeInRange(minValue T, maxValue T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.BeInRange(s.t, s.actual, minValue, maxValue, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeWithin reports a test failure if the number is not within tolerance of expected.
+//
+// The comparison is performed in float64, so it also works for integer subjects.
+func (s *NumberSubject[T]) BeWithin(expected T, tolerance T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.BeWithin(s.t, float64(s.actual), float64(expected), float64(tolerance), mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeOneOf reports a test failure if the number is not one of the provided options.
+func (s *NumberSubject[T]) BeOneOf(options []T, opts ...Option) *NumberSubject[T] {
+	s.t.Helper()
+	assert.BeOneOf(s.t, s.actual, options, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// SliceSubject is a fluent assertion chain for a slice.
+type SliceSubject[T any] struct {
+	t      testing.TB
+	actual []T
+	opts   []Option
+}
+
+// ThatSlice starts a fluent assertion chain for a slice.
+//
+// Example:
+//
+//	should.ThatSlice(t, ids).NotBeEmpty().Contain(42).NotContainDuplicates()
+func ThatSlice[T any](t testing.TB, actual []T, opts ...Option) *SliceSubject[T] {
+	return &SliceSubject[T]{t: t, actual: actual, opts: opts}
+}
+
+// BeEqual reports a test failure if the slice is not deeply equal to expected.
+func (s *SliceSubject[T]) BeEqual(expected []T, opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.BeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeEqual reports a test failure if the slice is deeply equal to expected.
+func (s *SliceSubject[T]) NotBeEqual(expected []T, opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.NotBeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeEmpty reports a test failure if the slice is not empty.
+func (s *SliceSubject[T]) BeEmpty(opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.BeEmpty(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeEmpty reports a test failure if the slice is empty.
+func (s *SliceSubject[T]) NotBeEmpty(opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.NotBeEmpty(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// HaveLength reports a test failure if the slice does not have the expected length.
+func (s *SliceSubject[T]) HaveLength(expected int, opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.HaveLength(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// Contain reports a test failure if the slice does not contain expected.
+func (s *SliceSubject[T]) Contain(expected T, opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.Contain(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotContain reports a test failure if the slice contains expected.
+func (s *SliceSubject[T]) NotContain(expected T, opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.NotContain(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotContainDuplicates reports a test failure if the slice contains duplicate values.
+func (s *SliceSubject[T]) NotContainDuplicates(opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.NotContainDuplicates(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// AnyMatch reports a test failure if no element of the slice satisfies predicate.
+func (s *SliceSubject[T]) AnyMatch(predicate func(T) bool, opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.AnyMatch(s.t, s.actual, predicate, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// AnyMatching reports a test failure if no element of the slice satisfies matcher.
+func (s *SliceSubject[T]) AnyMatching(matcher Matcher, opts ...Option) *SliceSubject[T] {
+	s.t.Helper()
+	assert.AnyMatching(s.t, s.actual, matcher, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// MapSubject is a fluent assertion chain for a map.
+type MapSubject[K comparable, V any] struct {
+	t      testing.TB
+	actual map[K]V
+	opts   []Option
+}
+
+// ThatMap starts a fluent assertion chain for a map.
+//
+// Example:
+//
+//	should.ThatMap(t, headers).ContainKey("Content-Type").NotContainKey("X-Debug")
+func ThatMap[K comparable, V any](t testing.TB, actual map[K]V, opts ...Option) *MapSubject[K, V] {
+	return &MapSubject[K, V]{t: t, actual: actual, opts: opts}
+}
+
+// BeEqual reports a test failure if the map is not deeply equal to expected.
+func (s *MapSubject[K, V]) BeEqual(expected map[K]V, opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.BeEqual(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeEmpty reports a test failure if the map is not empty.
+func (s *MapSubject[K, V]) BeEmpty(opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.BeEmpty(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeEmpty reports a test failure if the map is empty.
+func (s *MapSubject[K, V]) NotBeEmpty(opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.NotBeEmpty(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// HaveLength reports a test failure if the map does not have the expected number of entries.
+func (s *MapSubject[K, V]) HaveLength(expected int, opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.HaveLength(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// ContainKey reports a test failure if the map does not contain key.
+func (s *MapSubject[K, V]) ContainKey(key K, opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.ContainKey(s.t, s.actual, key, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotContainKey reports a test failure if the map contains key.
+func (s *MapSubject[K, V]) NotContainKey(key K, opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.NotContainKey(s.t, s.actual, key, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// ContainValue reports a test failure if the map does not contain value.
+func (s *MapSubject[K, V]) ContainValue(value V, opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.ContainValue(s.t, s.actual, value, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// ContainValueMatching reports a test failure if no value of the map satisfies matcher.
+func (s *MapSubject[K, V]) ContainValueMatching(matcher Matcher, opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.ContainValueMatching(s.t, s.actual, matcher, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotContainValue reports a test failure if the map contains value.
+func (s *MapSubject[K, V]) NotContainValue(value V, opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.NotContainValue(s.t, s.actual, value, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotContainValueMatching reports a test failure if any value of the map satisfies matcher.
+func (s *MapSubject[K, V]) NotContainValueMatching(matcher Matcher, opts ...Option) *MapSubject[K, V] {
+	s.t.Helper()
+	assert.NotContainValueMatching(s.t, s.actual, matcher, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// ErrorSubject is a fluent assertion chain for an error.
+type ErrorSubject struct {
+	t      testing.TB
+	actual error
+	opts   []Option
+}
+
+// ThatError starts a fluent assertion chain for an error.
+//
+// Example:
+//
+//	should.ThatError(t, err).BeError().BeErrorIs(os.ErrNotExist)
+func ThatError(t testing.TB, actual error, opts ...Option) *ErrorSubject {
+	return &ErrorSubject{t: t, actual: actual, opts: opts}
+}
+
+// BeError reports a test failure if the error is nil.
+func (s *ErrorSubject) BeError(opts ...Option) *ErrorSubject {
+	s.t.Helper()
+	assert.BeError(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// NotBeError reports a test failure if the error is not nil.
+func (s *ErrorSubject) NotBeError(opts ...Option) *ErrorSubject {
+	s.t.Helper()
+	assert.NotBeError(s.t, s.actual, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeErrorIs reports a test failure if target is not found in the error chain using errors.Is.
+func (s *ErrorSubject) BeErrorIs(target error, opts ...Option) *ErrorSubject {
+	s.t.Helper()
+	assert.BeErrorIs(s.t, s.actual, target, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// BeErrorAs reports a test failure if the error chain has no error assignable to target using errors.As.
+func (s *ErrorSubject) BeErrorAs(target any, opts ...Option) *ErrorSubject {
+	s.t.Helper()
+	assert.BeErrorAs(s.t, s.actual, target, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// TimeSubject is a fluent assertion chain for a time.Time value.
+type TimeSubject struct {
+	t      testing.TB
+	actual time.Time
+	opts   []Option
+}
+
+// ThatTime starts a fluent assertion chain for a time.Time value.
+//
+// Example:
+//
+//	should.ThatTime(t, createdAt, should.WithTruncate(time.Second)).BeSameTime(expected)
+func ThatTime(t testing.TB, actual time.Time, opts ...Option) *TimeSubject {
+	return &TimeSubject{t: t, actual: actual, opts: opts}
+}
+
+// BeSameTime reports a test failure if the time does not represent the same instant as expected.
+func (s *TimeSubject) BeSameTime(expected time.Time, opts ...Option) *TimeSubject {
+	s.t.Helper()
+	assert.BeSameTime(s.t, s.actual, expected, mergeOptions(s.opts, opts)...)
+	return s
+}
+
+// mergeOptions returns the subject options followed by the per-call options,
+// so that options given to a single assertion override the shared ones.
+func mergeOptions(shared, call []Option) []Option {
+	if len(call) == 0 {
+		return shared
+	}
+	if len(shared) == 0 {
+		return call
+	}
+	merged := make([]Option, 0, len(shared)+len(call))
+	merged = append(merged, shared...)
+	return append(merged, call...)
+}
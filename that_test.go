@@ -0,0 +1,237 @@
+package should
+
+import (
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// helperTB records the functions that marked themselves as helpers.
+type helperTB struct {
+	mockTB
+	helpers []string
+}
+
+func (h *helperTB) Helper() {
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		return
+	}
+	h.helpers = append(h.helpers, runtime.FuncForPC(pc).Name())
+}
+
+func TestThat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes when every assertion in the chain passes", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		That(mockT, []int{1, 2, 3}).NotBeNil().NotBeEmpty().HaveLength(3).BeEqual([]int{1, 2, 3})
+		if mockT.failed {
+			t.Errorf("Expected chain to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("reports the same message as the free function", func(t *testing.T) {
+		t.Parallel()
+		chained := &mockTB{}
+		That(chained, 42).BeEqual(43)
+
+		direct := &mockTB{}
+		BeEqual(direct, 42, 43)
+
+		if !chained.failed {
+			t.Fatal("Expected BeEqual to fail")
+		}
+		if chained.lastMessage != direct.lastMessage {
+			t.Errorf("Expected %q, got %q", direct.lastMessage, chained.lastMessage)
+		}
+	})
+
+	t.Run("applies subject options to every assertion", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		That(mockT, nil, WithMessage("user lookup")).NotBeNil()
+		if !strings.HasPrefix(mockT.lastMessage, "user lookup\n") {
+			t.Errorf("Expected custom message prefix, got %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("per-call options override subject options", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		That(mockT, nil, WithMessage("shared")).NotBeNil(WithMessage("specific"))
+		if !strings.HasPrefix(mockT.lastMessage, "specific\n") {
+			t.Errorf("Expected per-call message prefix, got %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("BeOfType fails for a different type", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		That(mockT, "text").BeOfType(0)
+		if !mockT.failed {
+			t.Error("Expected BeOfType to fail")
+		}
+	})
+}
+
+func TestThatString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes for a matching chain", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatString(mockT, "hello").StartWith("he").EndWith("lo").ContainSubstring("ll").HaveLength(5)
+		if mockT.failed {
+			t.Errorf("Expected chain to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("keeps reporting after a failure", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatString(mockT, "hello").StartWith("x").HaveLength(7)
+		if !mockT.failed {
+			t.Fatal("Expected chain to fail")
+		}
+		if !strings.Contains(mockT.lastMessage, "Expected collection to have specific length") {
+			t.Errorf("Expected last message to come from HaveLength, got %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("supports WithIgnoreCase", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatString(mockT, "Hello", WithIgnoreCase()).StartWith("HE").BeOneOf([]string{"Hello"})
+		if mockT.failed {
+			t.Errorf("Expected chain to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("matches regular expressions", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatString(mockT, "order-42").MatchRegex(`^order-\d+$`).NotMatchRegex(`^order-\d{3}`)
+		if mockT.failed {
+			t.Errorf("Expected chain to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+
+		ThatString(mockT, "order-42").NotMatchRegex(`\d+`)
+		if !strings.Contains(mockT.lastMessage, `Match   : "42" at position 6`) {
+			t.Errorf("Expected message from NotMatchRegex, got %q", mockT.lastMessage)
+		}
+	})
+}
+
+func TestThatNumber(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passes for a matching chain", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatNumber(mockT, 21).BeGreaterThan(18).BeLessThan(65).BeInRange(18, 65).BeOneOf([]int{21, 42})
+		if mockT.failed {
+			t.Errorf("Expected chain to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("BeWithin works with integer subjects", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatNumber(mockT, 100).BeWithin(98, 2)
+		if mockT.failed {
+			t.Errorf("Expected BeWithin to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("fails when the threshold is not met", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatNumber(mockT, 3.5).BeGreaterOrEqualTo(4.0)
+		if !mockT.failed {
+			t.Error("Expected BeGreaterOrEqualTo to fail")
+		}
+	})
+}
+
+func TestThatSliceAndMap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("slice chain", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatSlice(mockT, []string{"a", "b"}).Contain("a").NotContain("c").NotContainDuplicates().
+			AnyMatch(func(s string) bool { return s == "b" }).AnyMatching(Equal("a"))
+		if mockT.failed {
+			t.Errorf("Expected chain to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("map chain", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatMap(mockT, map[string]int{"a": 1}).ContainKey("a").NotContainKey("b").ContainValue(1).NotContainValue(2).
+			ContainValueMatching(LessThan(2)).NotContainValueMatching(GreaterThan(1))
+		if mockT.failed {
+			t.Errorf("Expected chain to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("map chain fails for a missing key", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatMap(mockT, map[string]int{"a": 1}).ContainKey("z")
+		if !mockT.failed {
+			t.Error("Expected ContainKey to fail")
+		}
+	})
+}
+
+func TestThatErrorAndTime(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error chain", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		err := errors.Join(errors.New("wrapped"), io.EOF)
+		ThatError(mockT, err).BeError().BeErrorIs(io.EOF)
+		if mockT.failed {
+			t.Errorf("Expected chain to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+
+	t.Run("error chain fails for nil", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		ThatError(mockT, nil).BeError()
+		if !mockT.failed {
+			t.Error("Expected BeError to fail")
+		}
+	})
+
+	t.Run("time chain honours options", func(t *testing.T) {
+		t.Parallel()
+		mockT := &mockTB{}
+		t1 := time.Date(2024, 8, 10, 15, 30, 0, 1_000, time.UTC)
+		t2 := time.Date(2024, 8, 10, 15, 30, 0, 9_000, time.UTC)
+		ThatTime(mockT, t1, WithTruncate(time.Second)).BeSameTime(t2)
+		if mockT.failed {
+			t.Errorf("Expected BeSameTime to pass, but it failed with message: %q", mockT.lastMessage)
+		}
+	})
+}
+
+func TestThat_MarksMethodsAsHelpers(t *testing.T) {
+	t.Parallel()
+
+	mockT := &helperTB{}
+	ThatString(mockT, "abc").StartWith("x")
+
+	if len(mockT.helpers) == 0 || !strings.HasSuffix(mockT.helpers[0], "(*StringSubject).StartWith") {
+		t.Errorf("Expected the subject method to be the first helper, got %v", mockT.helpers)
+	}
+}